@@ -0,0 +1,118 @@
+package command
+
+import (
+	"context"
+	"errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/uworldao/UWORLD/core/types"
+	"github.com/uworldao/UWORLD/rpc"
+	"github.com/uworldao/UWORLD/ut/transaction"
+	"strconv"
+	"time"
+)
+
+var ApproveCmd = &cobra.Command{
+	Use:     "Approve {from} {spender} {contract} {allowance} {note} {password} {nonce} {fees}; Grant the spender an allowance to transfer UWD or contract coins of the sender, 0 revokes the allowance;",
+	Aliases: []string{"approve", "ap", "AP"},
+	Short:   "Approve {from} {spender} {contract} {allowance} {note} {password} {nonce} {fees}; Grant the spender an allowance to transfer UWD or contract coins of the sender, 0 revokes the allowance;",
+	Example: `
+	Approve 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ 3ajNkh7yVYkETL9JKvGx3aL2YVNrqksjCUUE UWD 100 "approve note"
+		OR
+	Approve 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ 3ajNkh7yVYkETL9JKvGx3aL2YVNrqksjCUUE UWD 100 "approve note" 123456 0 0.01
+	`,
+	Args: cobra.MinimumNArgs(5),
+	Run:  Approve,
+}
+
+func Approve(cmd *cobra.Command, args []string) {
+	allowance, err := parseAmount(args[3])
+	if err != nil {
+		log.Error(cmd.Use+" err: ", errors.New("wrong allowance"))
+		return
+	}
+	privKey, err := readPrivate(cmd, args, 5)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	tx := transaction.NewApprove(args[0], args[1], args[2], args[4], allowance, 0)
+	if err := parseNonceFees(tx, args, 6); err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	sendSignedTx(cmd, tx, privKey)
+}
+
+var TransferFromCmd = &cobra.Command{
+	Use:     "TransferFrom {from} {owner} {to} {contract} {amount} {note} {password} {nonce} {fees}; Transfer UWD or contract coins of the owner within the allowance granted to the sender;",
+	Aliases: []string{"transferfrom", "tf", "TF"},
+	Short:   "TransferFrom {from} {owner} {to} {contract} {amount} {note} {password} {nonce} {fees}; Transfer UWD or contract coins of the owner within the allowance granted to the sender;",
+	Example: `
+	TransferFrom 3ajNkh7yVYkETL9JKvGx3aL2YVNrqksjCUUE 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ 3ajNkh7yVYkETL9JKvGx3aL2YVNrqksjCUUE UWD 10 "transfer note"
+		OR
+	TransferFrom 3ajNkh7yVYkETL9JKvGx3aL2YVNrqksjCUUE 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ 3ajNkh7yVYkETL9JKvGx3aL2YVNrqksjCUUE UWD 10 "transfer note" 123456 0 0.01
+	`,
+	Args: cobra.MinimumNArgs(6),
+	Run:  TransferFrom,
+}
+
+func TransferFrom(cmd *cobra.Command, args []string) {
+	amount, err := parseAmount(args[4])
+	if err != nil {
+		log.Error(cmd.Use+" err: ", errors.New("wrong amount"))
+		return
+	}
+	privKey, err := readPrivate(cmd, args, 6)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	tx := transaction.NewTransferFrom(args[0], args[1], args[2], args[3], args[5], amount, 0)
+	if err := parseNonceFees(tx, args, 7); err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	sendSignedTx(cmd, tx, privKey)
+}
+
+var GetAllowanceCmd = &cobra.Command{
+	Use:     "GetAllowance {owner} {spender} {contract}; Get the allowance that the spender can transfer from the owner;",
+	Aliases: []string{"getallowance", "gal", "GAL"},
+	Short:   "GetAllowance {owner} {spender} {contract}; Get the allowance that the spender can transfer from the owner;",
+	Example: `
+	GetAllowance 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ 3ajNkh7yVYkETL9JKvGx3aL2YVNrqksjCUUE UWD
+	`,
+	Args: cobra.MinimumNArgs(3),
+	Run:  GetAllowance,
+}
+
+func GetAllowance(cmd *cobra.Command, args []string) {
+	client, err := NewRpcClient()
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Second*20)
+	defer cancel()
+	resp, err := client.Gc.GetAllowance(ctx, &rpc.AllowanceReq{Owner: args[0], Spender: args[1], Contract: args[2]})
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	if resp.Code == 0 {
+		output(string(resp.Result))
+		return
+	}
+	outputRespError(cmd.Use, resp)
+}
+
+func parseAmount(arg string) (uint64, error) {
+	fAmount, err := strconv.ParseFloat(arg, 64)
+	if err != nil || fAmount < 0 {
+		return 0, errors.New("wrong amount")
+	}
+	return types.NewAmount(fAmount)
+}
@@ -0,0 +1,82 @@
+package command
+
+import (
+	"context"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/core/types"
+	"github.com/uworldao/UWORLD/rpc"
+	"github.com/uworldao/UWORLD/ut/transaction"
+	"strings"
+	"time"
+)
+
+var AnchorDigestsCmd = &cobra.Command{
+	Use:     "AnchorDigests {from} {digests} {note} {password} {nonce} {fees}; Anchor the 32-byte digests of documents on the chain, the digests are separated by commas;",
+	Aliases: []string{"anchordigests", "ad", "AD"},
+	Short:   "AnchorDigests {from} {digests} {note} {password} {nonce} {fees}; Anchor the 32-byte digests of documents on the chain, the digests are separated by commas;",
+	Example: `
+	AnchorDigests 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ 0x9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08 "anchor note"
+		OR
+	AnchorDigests 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ 0x9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08,0x60303ae22b998861bce3b28f33eec1be758a213c86c93c076dbe9f558c11c752 "anchor note" 123456 0 0.01
+	`,
+	Args: cobra.MinimumNArgs(3),
+	Run:  AnchorDigests,
+}
+
+func AnchorDigests(cmd *cobra.Command, args []string) {
+	var digests []hasharry.Hash
+	for _, s := range strings.Split(args[1], ",") {
+		digest, err := types.ParseDigest(strings.TrimSpace(s))
+		if err != nil {
+			log.Error(cmd.Use+" err: ", err)
+			return
+		}
+		digests = append(digests, digest)
+	}
+	privKey, err := readPrivate(cmd, args, 3)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	tx := transaction.NewAnchor(args[0], args[2], 0, digests)
+	if err := parseNonceFees(tx, args, 4); err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	sendSignedTx(cmd, tx, privKey)
+}
+
+var GetAnchorCmd = &cobra.Command{
+	Use:     "GetAnchor {digest}; Get the block and transaction that anchored the digest, with the proof against the block header;",
+	Aliases: []string{"getanchor", "gan", "GAN"},
+	Short:   "GetAnchor {digest}; Get the block and transaction that anchored the digest, with the proof against the block header;",
+	Example: `
+	GetAnchor 0x9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08
+	`,
+	Args: cobra.MinimumNArgs(1),
+	Run:  GetAnchor,
+}
+
+func GetAnchor(cmd *cobra.Command, args []string) {
+	client, err := NewRpcClient()
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Second*20)
+	defer cancel()
+	resp, err := client.Gc.GetAnchor(ctx, &rpc.Hash{Hash: args[0]})
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	if resp.Code == 0 {
+		output(string(resp.Result))
+		return
+	}
+	outputRespError(cmd.Use, resp)
+}
@@ -0,0 +1,83 @@
+package command
+
+import (
+	"context"
+	"errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/uworldao/UWORLD/core/types"
+	"github.com/uworldao/UWORLD/rpc"
+	"github.com/uworldao/UWORLD/ut/transaction"
+	"strconv"
+	"time"
+)
+
+var BurnCmd = &cobra.Command{
+	Use:     "Burn {from} {contract} {amount} {note} {password} {nonce} {fees}; Destroy the amount of UWD or contract coins;",
+	Aliases: []string{"burn", "bn", "BN"},
+	Short:   "Burn {from} {contract} {amount} {note} {password} {nonce} {fees}; Destroy the amount of UWD or contract coins;",
+	Example: `
+	Burn 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ UWD 10 "burn note"
+		OR
+	Burn 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ UWT3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb 10 "burn note" 123456 0 0.01
+	`,
+	Args: cobra.MinimumNArgs(4),
+	Run:  Burn,
+}
+
+func Burn(cmd *cobra.Command, args []string) {
+	fAmount, err := strconv.ParseFloat(args[2], 64)
+	if err != nil || fAmount < 0 {
+		log.Error(cmd.Use+" err: ", errors.New("wrong amount"))
+		return
+	}
+	amount, err := types.NewAmount(fAmount)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", errors.New("wrong amount"))
+		return
+	}
+	privKey, err := readPrivate(cmd, args, 4)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	tx := transaction.NewBurn(args[0], args[1], args[3], amount, 0)
+	if err := parseNonceFees(tx, args, 5); err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	sendSignedTx(cmd, tx, privKey)
+}
+
+var GetSupplyCmd = &cobra.Command{
+	Use:     "GetSupply {contract}; Get the issued, burned and circulating supply of UWD or a contract coin;",
+	Aliases: []string{"getsupply", "gs", "GS"},
+	Short:   "GetSupply {contract}; Get the issued, burned and circulating supply of UWD or a contract coin;",
+	Example: `
+	GetSupply UWD
+	`,
+	Args: cobra.MinimumNArgs(1),
+	Run:  GetSupply,
+}
+
+func GetSupply(cmd *cobra.Command, args []string) {
+	client, err := NewRpcClient()
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Second*20)
+	defer cancel()
+	resp, err := client.Gc.GetSupply(ctx, &rpc.Address{Address: args[0]})
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	if resp.Code == 0 {
+		output(string(resp.Result))
+		return
+	}
+	outputRespError(cmd.Use, resp)
+}
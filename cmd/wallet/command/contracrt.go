@@ -14,6 +14,7 @@ import (
 	"github.com/uworldao/UWORLD/ut"
 	"github.com/uworldao/UWORLD/ut/transaction"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -21,6 +22,10 @@ func init() {
 	contractCmds := []*cobra.Command{
 		GetContractCmd,
 		SendContractCmd,
+		MintContractCmd,
+		ContractPolicyCmd,
+		UpdateContractMetadataCmd,
+		TransferContractIssuerCmd,
 	}
 	RootCmd.AddCommand(contractCmds...)
 	RootSubCmdGroups["contract"] = contractCmds
@@ -28,15 +33,17 @@ func init() {
 }
 
 var SendContractCmd = &cobra.Command{
-	Use:     "SendContract {from} {to} {name} {abbr} {Increase} {description} {amount} {note} {password} {nonce}; Send contract of coin publish;",
+	Use:     "SendContract {from} {to} {name} {abbr} {Increase} {description} {amount} {note} {password} {nonce} {policies}; Send contract of coin publish, policies is a comma separated list of freeze, pause and allowlist;",
 	Aliases: []string{"sendcontract", "sc"},
-	Short:   "SendContract {from} {to} {name} {abbr} {Increase} {description} {amount} {note} {password} {nonce}; Send contract of coin publish;",
+	Short:   "SendContract {from} {to} {name} {abbr} {Increase} {description} {amount} {note} {password} {nonce} {policies}; Send contract of coin publish, policies is a comma separated list of freeze, pause and allowlist;",
 	Example: `
 	SendContract 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ 3ajNkh7yVYkETL9JKvGx3aL2YVNrqksjCUUE "Test Coin" TC false "description" 1000  "transaction note"
 		OR
 	SendContract 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ 3ajNkh7yVYkETL9JKvGx3aL2YVNrqksjCUUE "Test Coin" TC false "description" 1000  "transaction note" 123456
 		OR
 	SendContract 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ 3ajNkh7yVYkETL9JKvGx3aL2YVNrqksjCUUE "Test Coin" TC false "description" 1000  "transaction note" 123456 0
+		OR
+	SendContract 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ 3ajNkh7yVYkETL9JKvGx3aL2YVNrqksjCUUE "Test Coin" TC false "description" 1000  "transaction note" 123456 0 freeze,pause,allowlist
 	`,
 	Args: cobra.MinimumNArgs(8),
 	Run:  SendContract,
@@ -135,9 +142,169 @@ func parseSCParams(args []string) (*types.Transaction, error) {
 		}
 	}
 	tx := transaction.NewContract(from.String(), to.String(), contract, note, amount, nonce, name, abbr, increase, description)
+	if len(args) > 10 {
+		for _, name := range strings.Split(args[10], ",") {
+			policy, ok := types.ParsePolicy(strings.TrimSpace(name))
+			if !ok {
+				return nil, fmt.Errorf("unknown policy %s", name)
+			}
+			tx.TxBody.(*types.ContractBody).Policy |= policy
+		}
+	}
 	return tx, nil
 }
 
+var MintContractCmd = &cobra.Command{
+	Use:     "MintContract {from} {to} {contract} {amount} {note} {password} {nonce}; Issue additional coins of a contract published with increase true;",
+	Aliases: []string{"mintcontract", "mc", "MC"},
+	Short:   "MintContract {from} {to} {contract} {amount} {note} {password} {nonce}; Issue additional coins of a contract published with increase true;",
+	Example: `
+	MintContract 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ 3ajNkh7yVYkETL9JKvGx3aL2YVNrqksjCUUE UWT3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb 1000 "transaction note"
+		OR
+	MintContract 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ 3ajNkh7yVYkETL9JKvGx3aL2YVNrqksjCUUE UWT3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb 1000 "transaction note" 123456 0
+	`,
+	Args: cobra.MinimumNArgs(5),
+	Run:  MintContract,
+}
+
+// Issue additional coins, the name, abbr and description of the
+// contract are taken from the published contract.
+func MintContract(cmd *cobra.Command, args []string) {
+	fAmount, err := strconv.ParseFloat(args[3], 64)
+	if err != nil || fAmount < 0 {
+		log.Error(cmd.Use+" err: ", errors.New("wrong amount"))
+		return
+	}
+	amount, err := types.NewAmount(fAmount)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", errors.New("wrong amount"))
+		return
+	}
+	resp, err := GetContractByRpc(args[2])
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	if resp.Code != 0 {
+		outputRespError(cmd.Use, resp)
+		return
+	}
+	var contract *types.RpcContract
+	if err := json.Unmarshal(resp.Result, &contract); err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	if !contract.Increase {
+		log.Error(cmd.Use+" err: ", errors.New("this contract does not support additional issuance"))
+		return
+	}
+	privKey, err := readPrivate(cmd, args, 5)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	tx := transaction.NewContract(args[0], args[1], contract.Contract, args[4], amount, 0, contract.Name, contract.Abbr, true, contract.Description)
+	if len(args) > 6 {
+		nonce, err := strconv.ParseUint(args[6], 10, 64)
+		if err != nil {
+			log.Error(cmd.Use+" err: ", errors.New("wrong nonce"))
+			return
+		}
+		tx.TxHead.Nonce = nonce
+	}
+	sendSignedTx(cmd, tx, privKey)
+}
+
+var ContractPolicyCmd = &cobra.Command{
+	Use:     "ContractPolicy {from} {contract} {action} {holder} {note} {password} {nonce} {fees}; Change the policy of a contract published by the sender, action is freeze, unfreeze, pause, unpause, allow or disallow, holder is - for pause and unpause;",
+	Aliases: []string{"contractpolicy", "cp", "CP"},
+	Short:   "ContractPolicy {from} {contract} {action} {holder} {note} {password} {nonce} {fees}; Change the policy of a contract published by the sender, action is freeze, unfreeze, pause, unpause, allow or disallow, holder is - for pause and unpause;",
+	Example: `
+	ContractPolicy 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ UWT3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb freeze 3ajNkh7yVYkETL9JKvGx3aL2YVNrqksjCUUE "policy note"
+		OR
+	ContractPolicy 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ UWT3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb pause - "policy note" 123456 0 0.01
+	`,
+	Args: cobra.MinimumNArgs(5),
+	Run:  ContractPolicy,
+}
+
+func ContractPolicy(cmd *cobra.Command, args []string) {
+	action, ok := types.ParsePolicyAction(args[2])
+	if !ok {
+		log.Error(cmd.Use+" err: ", fmt.Errorf("unknown action %s", args[2]))
+		return
+	}
+	holder := args[3]
+	if !action.HasHolder() {
+		holder = ""
+	}
+	privKey, err := readPrivate(cmd, args, 5)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	tx := transaction.NewContractPolicy(args[0], args[1], args[4], 0, action, holder)
+	if err := parseNonceFees(tx, args, 6); err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	sendSignedTx(cmd, tx, privKey)
+}
+
+var UpdateContractMetadataCmd = &cobra.Command{
+	Use:     "UpdateContractMetadata {from} {contract} {description} {metadata uri} {note} {password} {nonce} {fees}; Update the description and the metadata uri of a contract issued by the sender;",
+	Aliases: []string{"updatecontractmetadata", "ucm", "UCM"},
+	Short:   "UpdateContractMetadata {from} {contract} {description} {metadata uri} {note} {password} {nonce} {fees}; Update the description and the metadata uri of a contract issued by the sender;",
+	Example: `
+	UpdateContractMetadata 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ UWT3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb "new description" "https://example.com/tc.json" "update note"
+		OR
+	UpdateContractMetadata 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ UWT3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb "new description" "https://example.com/tc.json" "update note" 123456 0 0.01
+	`,
+	Args: cobra.MinimumNArgs(5),
+	Run:  UpdateContractMetadata,
+}
+
+func UpdateContractMetadata(cmd *cobra.Command, args []string) {
+	privKey, err := readPrivate(cmd, args, 5)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	tx := transaction.NewContractMetadata(args[0], args[1], args[4], 0, args[2], args[3])
+	if err := parseNonceFees(tx, args, 6); err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	sendSignedTx(cmd, tx, privKey)
+}
+
+var TransferContractIssuerCmd = &cobra.Command{
+	Use:     "TransferContractIssuer {from} {contract} {issuer} {note} {password} {nonce} {fees}; Transfer the issuer rights of a contract issued by the sender;",
+	Aliases: []string{"transfercontractissuer", "tci", "TCI"},
+	Short:   "TransferContractIssuer {from} {contract} {issuer} {note} {password} {nonce} {fees}; Transfer the issuer rights of a contract issued by the sender;",
+	Example: `
+	TransferContractIssuer 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ UWT3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb 3ajNkh7yVYkETL9JKvGx3aL2YVNrqksjCUUE "transfer note"
+		OR
+	TransferContractIssuer 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ UWT3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb 3ajNkh7yVYkETL9JKvGx3aL2YVNrqksjCUUE "transfer note" 123456 0 0.01
+	`,
+	Args: cobra.MinimumNArgs(4),
+	Run:  TransferContractIssuer,
+}
+
+func TransferContractIssuer(cmd *cobra.Command, args []string) {
+	privKey, err := readPrivate(cmd, args, 4)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	tx := transaction.NewContractIssuer(args[0], args[1], args[3], 0, args[2])
+	if err := parseNonceFees(tx, args, 5); err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	sendSignedTx(cmd, tx, privKey)
+}
+
 var GetContractCmd = &cobra.Command{
 	Use:     "GetContract {contract address}; Get a contract;",
 	Aliases: []string{"getcontract", "gc", "GC"},
@@ -0,0 +1,204 @@
+package command
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/core/types"
+	"github.com/uworldao/UWORLD/rpc"
+	"github.com/uworldao/UWORLD/ut/transaction"
+	"strconv"
+	"time"
+)
+
+var LockHTLCCmd = &cobra.Command{
+	Use:     "LockHTLC {from} {to} {contract} {amount} {hashlock} {expireheight} {note} {password} {nonce} {fees}; Escrow the amount under the hash lock until the expire height;",
+	Aliases: []string{"lockhtlc", "lh", "LH"},
+	Short:   "LockHTLC {from} {to} {contract} {amount} {hashlock} {expireheight} {note} {password} {nonce} {fees}; Escrow the amount under the hash lock until the expire height;",
+	Example: `
+	LockHTLC 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ 3ajHhfRK5ZDz9TvjrXqhq2deLo8qk37zakxq UWD 10 0x9c56cc51b374c3ba189210d5b6d4bf57790d351c96c47c02190ecf1e430635ab 100000 "transaction note"
+		OR
+	LockHTLC 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ 3ajHhfRK5ZDz9TvjrXqhq2deLo8qk37zakxq UWD 10 0x9c56cc51b374c3ba189210d5b6d4bf57790d351c96c47c02190ecf1e430635ab 100000 "transaction note" 123456 0 0.01
+	`,
+	Args: cobra.MinimumNArgs(7),
+	Run:  LockHTLC,
+}
+
+func LockHTLC(cmd *cobra.Command, args []string) {
+	hashLock, err := hasharry.StringToHash(args[4])
+	if err != nil {
+		log.Error(cmd.Use+" err: ", errors.New("wrong hash lock"))
+		return
+	}
+	expireHeight, err := strconv.ParseUint(args[5], 10, 64)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", errors.New("wrong expire height"))
+		return
+	}
+	// Same positions as the parameters of SendTransaction
+	params := append([]string{}, args[:4]...)
+	params = append(params, args[6:]...)
+	nTx, err := parseParams(params)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	privKey, err := readPrivate(cmd, args, 7)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	tx := transaction.NewHTLCLock(args[0], args[1], args[2], nTx.GetNote(), nTx.GetTxBody().GetAmount(), nTx.GetNonce(), hashLock, expireHeight)
+	tx.TxHead.Fees = nTx.GetFees()
+	sendSignedTx(cmd, tx, privKey)
+}
+
+var ClaimHTLCCmd = &cobra.Command{
+	Use:     "ClaimHTLC {from} {id} {preimage} {password} {nonce} {fees}; Claim the escrowed amount of the HTLC with the preimage;",
+	Aliases: []string{"claimhtlc", "ch", "CH"},
+	Short:   "ClaimHTLC {from} {id} {preimage} {password} {nonce} {fees}; Claim the escrowed amount of the HTLC with the preimage;",
+	Example: `
+	ClaimHTLC 3ajHhfRK5ZDz9TvjrXqhq2deLo8qk37zakxq 0xef7b92e552dca02c97c9d596d1bf69d0044d95dec4cee0e6a20153e62bce893b 6a9f3c...
+		OR
+	ClaimHTLC 3ajHhfRK5ZDz9TvjrXqhq2deLo8qk37zakxq 0xef7b92e552dca02c97c9d596d1bf69d0044d95dec4cee0e6a20153e62bce893b 6a9f3c... 123456 0 0.01
+	`,
+	Args: cobra.MinimumNArgs(3),
+	Run:  ClaimHTLC,
+}
+
+func ClaimHTLC(cmd *cobra.Command, args []string) {
+	id, err := hasharry.StringToHash(args[1])
+	if err != nil {
+		log.Error(cmd.Use+" err: ", errors.New("wrong htlc id"))
+		return
+	}
+	preimage, err := hex.DecodeString(args[2])
+	if err != nil {
+		log.Error(cmd.Use+" err: ", errors.New("wrong preimage"))
+		return
+	}
+	privKey, err := readPrivate(cmd, args, 3)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	tx := transaction.NewHTLCClaim(args[0], "", 0, id, preimage)
+	if err := parseNonceFees(tx, args, 4); err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	sendSignedTx(cmd, tx, privKey)
+}
+
+var RefundHTLCCmd = &cobra.Command{
+	Use:     "RefundHTLC {from} {id} {password} {nonce} {fees}; Refund the escrowed amount of the expired HTLC;",
+	Aliases: []string{"refundhtlc", "rh", "RH"},
+	Short:   "RefundHTLC {from} {id} {password} {nonce} {fees}; Refund the escrowed amount of the expired HTLC;",
+	Example: `
+	RefundHTLC 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ 0xef7b92e552dca02c97c9d596d1bf69d0044d95dec4cee0e6a20153e62bce893b
+		OR
+	RefundHTLC 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ 0xef7b92e552dca02c97c9d596d1bf69d0044d95dec4cee0e6a20153e62bce893b 123456 0 0.01
+	`,
+	Args: cobra.MinimumNArgs(2),
+	Run:  RefundHTLC,
+}
+
+func RefundHTLC(cmd *cobra.Command, args []string) {
+	id, err := hasharry.StringToHash(args[1])
+	if err != nil {
+		log.Error(cmd.Use+" err: ", errors.New("wrong htlc id"))
+		return
+	}
+	privKey, err := readPrivate(cmd, args, 2)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	tx := transaction.NewHTLCRefund(args[0], "", 0, id)
+	if err := parseNonceFees(tx, args, 3); err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	sendSignedTx(cmd, tx, privKey)
+}
+
+var GetHTLCCmd = &cobra.Command{
+	Use:     "GetHTLC {id}; Get the HTLC by the hash of the lock transaction;",
+	Aliases: []string{"gethtlc", "gh", "GH"},
+	Short:   "GetHTLC {id}; Get the HTLC by the hash of the lock transaction;",
+	Example: `
+	GetHTLC 0xef7b92e552dca02c97c9d596d1bf69d0044d95dec4cee0e6a20153e62bce893b
+	`,
+	Args: cobra.MinimumNArgs(1),
+	Run:  GetHTLC,
+}
+
+func GetHTLC(cmd *cobra.Command, args []string) {
+	client, err := NewRpcClient()
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Second*20)
+	defer cancel()
+	resp, err := client.Gc.GetHTLC(ctx, &rpc.Hash{Hash: args[0]})
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	if resp.Code == 0 {
+		output(string(resp.Result))
+		return
+	}
+	outputRespError(cmd.Use, resp)
+}
+
+var GenerateHTLCSecretCmd = &cobra.Command{
+	Use:     "GenerateHTLCSecret; Generate a random preimage and its hash lock;",
+	Aliases: []string{"generatehtlcsecret", "ghs", "GHS"},
+	Short:   "GenerateHTLCSecret; Generate a random preimage and its hash lock;",
+	Example: `
+	GenerateHTLCSecret
+	`,
+	Args: cobra.MinimumNArgs(0),
+	Run:  GenerateHTLCSecret,
+}
+
+func GenerateHTLCSecret(cmd *cobra.Command, args []string) {
+	preimage := make([]byte, 32)
+	if _, err := rand.Read(preimage); err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	fmt.Println()
+	fmt.Println("preimage:", hex.EncodeToString(preimage))
+	fmt.Println("hashlock:", types.HashLock(preimage).String())
+}
+
+// Read the private key of the sender, the password is at the index
+// of the arguments or is entered
+func readPrivate(cmd *cobra.Command, args []string, index int) (string, error) {
+	var passwd []byte
+	var err error
+	if len(args) > index {
+		passwd = []byte(args[index])
+	} else {
+		fmt.Println("please input password：")
+		passwd, err = readPassWd()
+		if err != nil {
+			return "", fmt.Errorf("read password failed! %s", err.Error())
+		}
+	}
+	privKey, err := ReadAddrPrivate(getAddJsonPath(args[0]), passwd)
+	if err != nil {
+		return "", fmt.Errorf("wrong password")
+	}
+	return privKey.Private, nil
+}
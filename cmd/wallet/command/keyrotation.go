@@ -0,0 +1,68 @@
+package command
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/uworldao/UWORLD/common/keystore"
+	"github.com/uworldao/UWORLD/crypto/ecc/secp256k1"
+	"github.com/uworldao/UWORLD/ut/transaction"
+)
+
+var RotateKeyCmd = &cobra.Command{
+	Use:     "RotateKey {from} {new} {note} {password} {newpassword} {nonce} {fees}; Bind the key of the new account to the address, the address keeps its balances, issued contracts and candidate status, its keystore is replaced by the new key;",
+	Aliases: []string{"rotatekey", "rk", "RK"},
+	Short:   "RotateKey {from} {new} {note} {password} {newpassword} {nonce} {fees}; Bind the key of the new account to the address, the address keeps its balances, issued contracts and candidate status, its keystore is replaced by the new key;",
+	Example: `
+	RotateKey 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ 3ajNkh7yVYkETL9JKvGx3aL2YVNrqksjCUUE "rotate key"
+		OR
+	RotateKey 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ 3ajNkh7yVYkETL9JKvGx3aL2YVNrqksjCUUE "rotate key" 123456 654321 0 0.01
+	`,
+	Args: cobra.MinimumNArgs(3),
+	Run:  RotateKey,
+}
+
+func RotateKey(cmd *cobra.Command, args []string) {
+	privKey, err := readPrivate(cmd, args, 3)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	var newPasswd []byte
+	if len(args) > 4 {
+		newPasswd = []byte(args[4])
+	} else {
+		fmt.Println("please input the password of the new account：")
+		newPasswd, err = readPassWd()
+		if err != nil {
+			log.Error(cmd.Use+" err: ", fmt.Errorf("read password failed! %s", err.Error()))
+			return
+		}
+	}
+	newPrivate, err := ReadAddrPrivate(getAddJsonPath(args[1]), newPasswd)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", errors.New("wrong password of the new account"))
+		return
+	}
+	newKey, err := secp256k1.ParseStringToPrivate(newPrivate.Private)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	tx := transaction.NewKeyRotation(args[0], args[2], 0, newKey.PubKey().SerializeCompressed())
+	if err := parseNonceFees(tx, args, 5); err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	if !sendSignedTx(cmd, tx, privKey) {
+		return
+	}
+	if _, err := keystore.RotateKeyJson(Net, Cfg.KeyStoreDir, args[0], newKey, newPrivate.Mnemonic, newPasswd); err != nil {
+		log.Error(cmd.Use+" err: ", fmt.Errorf("update keystore failed! %s", err.Error()))
+		return
+	}
+	fmt.Printf("the keystore of %s now holds the key %s, the password of %s is used\n",
+		args[0], hex.EncodeToString(newKey.PubKey().SerializeCompressed()), args[1])
+}
@@ -0,0 +1,97 @@
+package command
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/core/types"
+	"github.com/uworldao/UWORLD/crypto/ecc/secp256k1"
+	"github.com/uworldao/UWORLD/rpc"
+	"github.com/uworldao/UWORLD/rpc/rpctypes"
+	"os"
+	"time"
+)
+
+// Transaction with the decrypted memo
+type memoTransaction struct {
+	*types.RpcTransactionConfirmed
+	DecryptedMemo string `json:"decryptedmemo"`
+}
+
+// Encrypt the memo with the public key of the receiver. If the
+// public key is not provided, it is taken from the chain, which
+// also knows the keys bound by key rotations. A provided public
+// key must belong to the address of the receiver.
+func encryptMemo(to string, pubKey string, memo string) ([]byte, error) {
+	provided := pubKey != ""
+	if !provided {
+		resp, err := GetPubKeyByRpc(to)
+		if err != nil {
+			return nil, err
+		}
+		if resp.Code != 0 {
+			return nil, fmt.Errorf("the public key of %s is unknown, provide it to encrypt the memo", to)
+		}
+		var key *rpctypes.PubKey
+		if err := json.Unmarshal(resp.Result, &key); err != nil {
+			return nil, err
+		}
+		pubKey = key.PubKey
+	}
+	keyBytes, err := hex.DecodeString(pubKey)
+	if err != nil {
+		return nil, errors.New("wrong public key")
+	}
+	if provided && !types.VerifySigner(Net, hasharry.StringToAddress(to), keyBytes) {
+		return nil, fmt.Errorf("the public key does not belong to %s", to)
+	}
+	return types.EncryptMemo(keyBytes, memo)
+}
+
+// Decrypt the memo of the transaction with the keystore of the receiver
+func decryptMemo(result []byte, password []byte) ([]byte, error) {
+	var rpcTx *types.RpcTransactionConfirmed
+	if err := json.Unmarshal(result, &rpcTx); err != nil {
+		return nil, err
+	}
+	if rpcTx.TxHead.Memo == "" {
+		return result, nil
+	}
+	tx, err := types.TranslateRpcTxToTx(&types.RpcTransaction{TxHead: rpcTx.TxHead, TxBody: rpcTx.TxBody})
+	if err != nil {
+		return nil, err
+	}
+	to := tx.GetTxBody().ToAddress().String()
+	keyFile := getAddJsonPath(to)
+	if _, err := os.Stat(keyFile); err != nil {
+		return nil, fmt.Errorf("the keystore of the receiver %s is not found", to)
+	}
+	privKey, err := ReadAddrPrivate(keyFile, password)
+	if err != nil {
+		return nil, errors.New("wrong password")
+	}
+	key, err := secp256k1.ParseStringToPrivate(privKey.Private)
+	if err != nil {
+		return nil, err
+	}
+	memo, err := types.DecryptMemo(key, tx.TxHead.Memo)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt memo failed, %s", err.Error())
+	}
+	return json.Marshal(&memoTransaction{RpcTransactionConfirmed: rpcTx, DecryptedMemo: memo})
+}
+
+func GetPubKeyByRpc(addr string) (*rpc.Response, error) {
+	client, err := NewRpcClient()
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Second*20)
+	defer cancel()
+	return client.Gc.GetPubKey(ctx, &rpc.Address{Address: addr})
+}
@@ -0,0 +1,187 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/uworldao/UWORLD/core/types"
+	"github.com/uworldao/UWORLD/rpc"
+	"github.com/uworldao/UWORLD/ut/transaction"
+	"strings"
+	"time"
+)
+
+var RegisterNameCmd = &cobra.Command{
+	Use:     "RegisterName {from} {name} {note} {password} {nonce} {fees}; Register a name for the sender, the fees are at least 1 UWD;",
+	Aliases: []string{"registername", "rn", "RN"},
+	Short:   "RegisterName {from} {name} {note} {password} {nonce} {fees}; Register a name for the sender, the fees are at least 1 UWD;",
+	Example: `
+	RegisterName 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ alice "register note"
+		OR
+	RegisterName 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ alice "register note" 123456 0 1
+	`,
+	Args: cobra.MinimumNArgs(3),
+	Run:  RegisterName,
+}
+
+func RegisterName(cmd *cobra.Command, args []string) {
+	if err := types.CheckName(args[1]); err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	privKey, err := readPrivate(cmd, args, 3)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	tx := transaction.NewNameRegister(args[0], args[1], args[2], 0)
+	if err := parseNonceFees(tx, args, 4); err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	sendSignedTx(cmd, tx, privKey)
+}
+
+var RenewNameCmd = &cobra.Command{
+	Use:     "RenewName {from} {name} {note} {password} {nonce} {fees}; Extend the name owned by the sender, the fees are at least 1 UWD;",
+	Aliases: []string{"renewname", "rnn", "RNN"},
+	Short:   "RenewName {from} {name} {note} {password} {nonce} {fees}; Extend the name owned by the sender, the fees are at least 1 UWD;",
+	Example: `
+	RenewName 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ alice "renew note"
+		OR
+	RenewName 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ alice "renew note" 123456 0 1
+	`,
+	Args: cobra.MinimumNArgs(3),
+	Run:  RenewName,
+}
+
+func RenewName(cmd *cobra.Command, args []string) {
+	privKey, err := readPrivate(cmd, args, 3)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	tx := transaction.NewNameRenew(args[0], args[1], args[2], 0)
+	if err := parseNonceFees(tx, args, 4); err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	sendSignedTx(cmd, tx, privKey)
+}
+
+var TransferNameCmd = &cobra.Command{
+	Use:     "TransferName {from} {name} {to} {note} {password} {nonce} {fees}; Transfer the name owned by the sender to the receiver;",
+	Aliases: []string{"transfername", "tn", "TN"},
+	Short:   "TransferName {from} {name} {to} {note} {password} {nonce} {fees}; Transfer the name owned by the sender to the receiver;",
+	Example: `
+	TransferName 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ alice 3ajNkh7yVYkETL9JKvGx3aL2YVNrqksjCUUE "transfer note"
+		OR
+	TransferName 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ alice 3ajNkh7yVYkETL9JKvGx3aL2YVNrqksjCUUE "transfer note" 123456 0 0.01
+	`,
+	Args: cobra.MinimumNArgs(4),
+	Run:  TransferName,
+}
+
+func TransferName(cmd *cobra.Command, args []string) {
+	privKey, err := readPrivate(cmd, args, 4)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	tx := transaction.NewNameTransfer(args[0], args[1], args[2], args[3], 0)
+	if err := parseNonceFees(tx, args, 5); err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	sendSignedTx(cmd, tx, privKey)
+}
+
+var ResolveNameCmd = &cobra.Command{
+	Use:     "ResolveName {name}; Get the owner of the name;",
+	Aliases: []string{"resolvename", "rsn", "RSN"},
+	Short:   "ResolveName {name}; Get the owner of the name;",
+	Example: `
+	ResolveName alice
+	`,
+	Args: cobra.MinimumNArgs(1),
+	Run:  ResolveName,
+}
+
+func ResolveName(cmd *cobra.Command, args []string) {
+	resp, err := ResolveNameByRpc(strings.TrimPrefix(args[0], "@"))
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	if resp.Code == 0 {
+		output(string(resp.Result))
+		return
+	}
+	outputRespError(cmd.Use, resp)
+}
+
+var ReverseLookupCmd = &cobra.Command{
+	Use:     "ReverseLookup {address}; Get the names owned by the address;",
+	Aliases: []string{"reverselookup", "rl", "RL"},
+	Short:   "ReverseLookup {address}; Get the names owned by the address;",
+	Example: `
+	ReverseLookup 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ
+	`,
+	Args: cobra.MinimumNArgs(1),
+	Run:  ReverseLookup,
+}
+
+func ReverseLookup(cmd *cobra.Command, args []string) {
+	client, err := NewRpcClient()
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Second*20)
+	defer cancel()
+	resp, err := client.Gc.ReverseLookup(ctx, &rpc.Address{Address: args[0]})
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	if resp.Code == 0 {
+		output(string(resp.Result))
+		return
+	}
+	outputRespError(cmd.Use, resp)
+}
+
+func ResolveNameByRpc(name string) (*rpc.Response, error) {
+	client, err := NewRpcClient()
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Second*20)
+	defer cancel()
+	return client.Gc.ResolveName(ctx, &rpc.Name{Name: name})
+}
+
+// Resolve the receiver given as @name to the address of the owner
+func parseReceiver(to string) (string, error) {
+	if !strings.HasPrefix(to, "@") {
+		return to, nil
+	}
+	resp, err := ResolveNameByRpc(to[1:])
+	if err != nil {
+		return "", err
+	}
+	if resp.Code != 0 {
+		return "", fmt.Errorf("resolve %s failed, code %d, message: %s", to, resp.Code, resp.Err)
+	}
+	var name *types.RpcName
+	if err := json.Unmarshal(resp.Result, &name); err != nil {
+		return "", err
+	}
+	return name.Owner, nil
+}
@@ -2,9 +2,11 @@ package command
 
 import (
 	"context"
+	"errors"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/uworldao/UWORLD/rpc"
+	"strconv"
 	"time"
 )
 
@@ -12,6 +14,11 @@ func init() {
 	nodeCmds := []*cobra.Command{
 		GetLastHeightCmd,
 		GetTxPoolTxs,
+		GetPoolAddressTxsCmd,
+		GetPoolStatusCmd,
+		GetPoolRejectedTxsCmd,
+		GetBlockTemplateCmd,
+		EstimateFeeCmd,
 		GetPeersCmd,
 		AccountsCmd,
 		NodeInfoCmd,
@@ -56,6 +63,183 @@ func GetTxPool(cmd *cobra.Command, args []string) {
 	outputRespError(cmd.Use, resp)
 }
 
+var GetPoolAddressTxsCmd = &cobra.Command{
+	Use:     "GetPoolAddressTxs {address}; Get the transactions of the address in the transaction pool and the missing nonce values;",
+	Aliases: []string{"getpooladdresstxs", "gpa", "GPA"},
+	Short:   "GetPoolAddressTxs {address}; Get the transactions of the address in the transaction pool and the missing nonce values;",
+	Example: `
+	GetPoolAddressTxs 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ
+	`,
+	Args: cobra.MinimumNArgs(1),
+	Run:  GetPoolAddressTxs,
+}
+
+func GetPoolAddressTxs(cmd *cobra.Command, args []string) {
+	client, err := NewRpcClient()
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Second*20)
+	defer cancel()
+	resp, err := client.Gc.GetPoolAddressTxs(ctx, &rpc.Address{Address: args[0]})
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	if resp.Code == 0 {
+		output(string(resp.Result))
+		return
+	}
+	outputRespError(cmd.Use, resp)
+}
+
+var GetPoolStatusCmd = &cobra.Command{
+	Use:     "GetPoolStatus",
+	Short:   "GetPoolStatus; Get the counts, the size and the oldest transaction of the transaction pool;",
+	Aliases: []string{"getpoolstatus", "gps", "GPS"},
+	Example: `
+	GetPoolStatus
+	`,
+	Args: cobra.MinimumNArgs(0),
+	Run:  GetPoolStatus,
+}
+
+func GetPoolStatus(cmd *cobra.Command, args []string) {
+	client, err := NewRpcClient()
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Second*20)
+	defer cancel()
+	resp, err := client.Gc.GetPoolStatus(ctx, &rpc.Null{})
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	if resp.Code == 0 {
+		output(string(resp.Result))
+		return
+	}
+	outputRespError(cmd.Use, resp)
+}
+
+var GetBlockTemplateCmd = &cobra.Command{
+	Use:     "GetBlockTemplate",
+	Short:   "GetBlockTemplate; Get the transactions that the next block would contain;",
+	Aliases: []string{"getblocktemplate", "gbt", "GBT"},
+	Example: `
+	GetBlockTemplate
+	`,
+	Args: cobra.MinimumNArgs(0),
+	Run:  GetBlockTemplate,
+}
+
+func GetBlockTemplate(cmd *cobra.Command, args []string) {
+	client, err := NewRpcClient()
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Second*20)
+	defer cancel()
+	resp, err := client.Gc.GetBlockTemplate(ctx, &rpc.Null{})
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	if resp.Code == 0 {
+		output(string(resp.Result))
+		return
+	}
+	outputRespError(cmd.Use, resp)
+}
+
+var GetPoolRejectedTxsCmd = &cobra.Command{
+	Use:     "GetPoolRejectedTxs",
+	Short:   "GetPoolRejectedTxs; Get the transactions recently rejected or evicted by the transaction pool with the reason;",
+	Aliases: []string{"getpoolrejectedtxs", "gpr", "GPR"},
+	Example: `
+	GetPoolRejectedTxs
+	`,
+	Args: cobra.MinimumNArgs(0),
+	Run:  GetPoolRejectedTxs,
+}
+
+func GetPoolRejectedTxs(cmd *cobra.Command, args []string) {
+	client, err := NewRpcClient()
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Second*20)
+	defer cancel()
+	resp, err := client.Gc.GetPoolRejectedTxs(ctx, &rpc.Null{})
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	if resp.Code == 0 {
+		output(string(resp.Result))
+		return
+	}
+	outputRespError(cmd.Use, resp)
+}
+
+var EstimateFeeCmd = &cobra.Command{
+	Use:     "EstimateFee",
+	Short:   "EstimateFee {size}; Get the minimum fee of the next block and the fee suggested for a transaction of the size;",
+	Aliases: []string{"estimatefee", "ef", "EF"},
+	Example: `
+	EstimateFee 
+		OR
+	EstimateFee 300
+	`,
+	Args: cobra.MinimumNArgs(0),
+	Run:  EstimateFee,
+}
+
+func EstimateFee(cmd *cobra.Command, args []string) {
+	var size uint64
+	if len(args) > 0 {
+		var err error
+		if size, err = strconv.ParseUint(args[0], 10, 64); err != nil {
+			log.Error(cmd.Use+" err: ", errors.New("wrong size"))
+			return
+		}
+	}
+	resp, err := EstimateFeeRpc(size)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	if resp.Code == 0 {
+		output(string(resp.Result))
+		return
+	}
+	outputRespError(cmd.Use, resp)
+}
+
+func EstimateFeeRpc(size uint64) (*rpc.Response, error) {
+	client, err := NewRpcClient()
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Second*20)
+	defer cancel()
+	return client.Gc.EstimateFee(ctx, &rpc.TxSize{Size: size})
+}
 
 //GenerateCmd cpu mine block
 var AccountsCmd = &cobra.Command{
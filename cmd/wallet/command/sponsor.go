@@ -0,0 +1,168 @@
+package command
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/core/types"
+	"github.com/uworldao/UWORLD/crypto/ecc/secp256k1"
+	"github.com/uworldao/UWORLD/rpc/rpctypes"
+)
+
+var CreateSponsoredTransactionCmd = &cobra.Command{
+	Use:     "CreateSponsoredTransaction {from} {to} {contract} {amount} {note} {payer} {password} {nonce} {fees}; Create a transaction whose fees are paid by the payer;",
+	Aliases: []string{"createsponsoredtransaction", "cst", "CST"},
+	Short:   "CreateSponsoredTransaction {from} {to} {contract} {amount} {note} {payer} {password} {nonce} {fees}; Create a transaction whose fees are paid by the payer;",
+	Example: `
+	CreateSponsoredTransaction 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ UWT3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb 10 "transaction note" 3ajHhfRK5ZDz9TvjrXqhq2deLo8qk37zakxq
+		OR
+	CreateSponsoredTransaction 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ UWT3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb 10 "transaction note" 3ajHhfRK5ZDz9TvjrXqhq2deLo8qk37zakxq 123456
+	`,
+	Args: cobra.MinimumNArgs(6),
+	Run:  CreateSponsoredTransaction,
+}
+
+// The sender signs the transaction, the output is handed to the payer
+func CreateSponsoredTransaction(cmd *cobra.Command, args []string) {
+	var passwd []byte
+	var err error
+	if len(args) > 6 {
+		passwd = []byte(args[6])
+	} else {
+		fmt.Println("please input password：")
+		passwd, err = readPassWd()
+		if err != nil {
+			log.Error(cmd.Use+" err: ", fmt.Errorf("read password failed! %s", err.Error()))
+			return
+		}
+	}
+	privKey, err := ReadAddrPrivate(getAddJsonPath(args[0]), passwd)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", fmt.Errorf("wrong password"))
+		return
+	}
+
+	// Same positions as the parameters of SendTransaction
+	params := append([]string{}, args[:5]...)
+	params = append(params, args[6:]...)
+	tx, err := parseParams(params)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	tx.TxHead.Payer = hasharry.StringToAddress(args[5])
+
+	resp, err := GetAccountByRpc(tx.From().String())
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	if resp.Code != 0 {
+		log.Errorf(cmd.Use+" err: code %d, message: %s", resp.Code, resp.Err)
+		return
+	}
+	var account *rpctypes.Account
+	if err := json.Unmarshal(resp.Result, &account); err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	if tx.TxHead.Nonce == 0 {
+		tx.TxHead.Nonce = account.Nonce + 1
+	}
+	if !signTx(cmd, tx, privKey.Private) {
+		log.Error(cmd.Use+" err: ", errors.New("signature failure"))
+		return
+	}
+
+	rpcTx, err := types.TranslateTxToRpcTx(tx)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	bytes, err := json.Marshal(rpcTx)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	fmt.Println()
+	fmt.Println(string(bytes))
+}
+
+var SponsorTransactionCmd = &cobra.Command{
+	Use:     "SponsorTransaction {transaction} {password}; Sign a sponsored transaction as the payer and send it;",
+	Aliases: []string{"sponsortransaction", "spt", "SPT"},
+	Short:   "SponsorTransaction {transaction} {password}; Sign a sponsored transaction as the payer and send it;",
+	Example: `
+	SponsorTransaction '{"txhead":{...},"txbody":{...}}'
+		OR
+	SponsorTransaction '{"txhead":{...},"txbody":{...}}' 123456
+	`,
+	Args: cobra.MinimumNArgs(1),
+	Run:  SponsorTransaction,
+}
+
+// The payer checks the transaction signed by the sender, signs it and sends it
+func SponsorTransaction(cmd *cobra.Command, args []string) {
+	var rpcTx *types.RpcTransaction
+	if err := json.Unmarshal([]byte(args[0]), &rpcTx); err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	tx, err := types.TranslateRpcTxToTx(rpcTx)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	if !tx.IsSponsored() {
+		log.Error(cmd.Use+" err: ", errors.New("the transaction has no payer"))
+		return
+	}
+	hashTx, _ := types.TranslateRpcTxToTx(rpcTx)
+	if err := hashTx.SetHash(); err != nil || !hashTx.Hash().IsEqual(tx.Hash()) {
+		log.Error(cmd.Use+" err: ", types.ErrTxHash)
+		return
+	}
+	if !types.Verify(tx.Hash(), tx.GetSignScript()) {
+		log.Error(cmd.Use+" err: ", types.ErrSignature)
+		return
+	}
+
+	var passwd []byte
+	if len(args) > 1 {
+		passwd = []byte(args[1])
+	} else {
+		fmt.Println("please input password：")
+		passwd, err = readPassWd()
+		if err != nil {
+			log.Error(cmd.Use+" err: ", fmt.Errorf("read password failed! %s", err.Error()))
+			return
+		}
+	}
+	privKey, err := ReadAddrPrivate(getAddJsonPath(tx.GetPayer().String()), passwd)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", fmt.Errorf("wrong password"))
+		return
+	}
+	priv, err := secp256k1.ParseStringToPrivate(privKey.Private)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", errors.New("[key] wrong"))
+		return
+	}
+	if err := tx.SignPayer(priv); err != nil {
+		log.Error(cmd.Use+" err: ", errors.New("sign failed"))
+		return
+	}
+
+	rs, err := sendTx(cmd, tx)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+	} else if rs.Code != 0 {
+		log.Errorf(cmd.Use+" err: code %d, message: %s", rs.Code, rs.Err)
+	} else {
+		fmt.Println()
+		fmt.Println(string(rs.Result))
+	}
+}
@@ -0,0 +1,80 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/uworldao/UWORLD/core/types"
+	"strconv"
+)
+
+var SendTimeLockTransactionCmd = &cobra.Command{
+	Use:     "SendTimeLockTransaction {from} {to} {contract} {amount} {unlockheight} {unlocktime} {note} {password} {nonce} {fees}; Send a transaction whose amount is locked until the unlock height and unlock time;",
+	Aliases: []string{"sendtimelocktransaction", "stl", "STL"},
+	Short:   "SendTimeLockTransaction {from} {to} {contract} {amount} {unlockheight} {unlocktime} {note} {password} {nonce} {fees}; Send a transaction whose amount is locked until the unlock height and unlock time;",
+	Example: `
+	SendTimeLockTransaction 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ UWD 10 100000 0 "transaction note"
+		OR
+	SendTimeLockTransaction 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ UWD 10 0 1640966400 "transaction note" 123456
+		OR
+	SendTimeLockTransaction 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ UWD 10 100000 1640966400 "transaction note" 123456 0 0.01
+	`,
+	Args: cobra.MinimumNArgs(7),
+	Run:  SendTimeLockTransaction,
+}
+
+func SendTimeLockTransaction(cmd *cobra.Command, args []string) {
+	var passwd []byte
+	var err error
+	if len(args) > 7 {
+		passwd = []byte(args[7])
+	} else {
+		fmt.Println("please input password：")
+		passwd, err = readPassWd()
+		if err != nil {
+			log.Error(cmd.Use+" err: ", fmt.Errorf("read password failed! %s", err.Error()))
+			return
+		}
+	}
+	privKey, err := ReadAddrPrivate(getAddJsonPath(args[0]), passwd)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", fmt.Errorf("wrong password"))
+		return
+	}
+
+	tx, err := parseTimeLockParams(args)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	sendSignedTx(cmd, tx, privKey.Private)
+}
+
+// The parameters are the same as SendTransaction except for the
+// unlock height and unlock time after the amount.
+func parseTimeLockParams(args []string) (*types.Transaction, error) {
+	unlockHeight, err := strconv.ParseUint(args[4], 10, 64)
+	if err != nil {
+		return nil, errors.New("wrong unlock height")
+	}
+	unlockTime, err := strconv.ParseUint(args[5], 10, 64)
+	if err != nil {
+		return nil, errors.New("wrong unlock time")
+	}
+	params := append([]string{}, args[:4]...)
+	params = append(params, args[6:]...)
+	tx, err := parseParams(params)
+	if err != nil {
+		return nil, err
+	}
+	tx.TxHead.TxType = types.TimeLockTransaction
+	tx.TxBody = &types.TimeLockTransactionBody{
+		Contract:     tx.GetTxBody().GetContract(),
+		To:           tx.GetTxBody().ToAddress(),
+		Amount:       tx.GetTxBody().GetAmount(),
+		UnlockHeight: unlockHeight,
+		UnlockTime:   unlockTime,
+	}
+	return tx, nil
+}
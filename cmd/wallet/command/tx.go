@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -21,27 +22,168 @@ func init() {
 	txCmds := []*cobra.Command{
 		GetTransactionCmd,
 		SendTransactionCmd,
+		SimulateTransactionCmd,
+		DecodeTransactionCmd,
+		CreateSponsoredTransactionCmd,
+		SponsorTransactionCmd,
+		SendTimeLockTransactionCmd,
+		LockHTLCCmd,
+		ClaimHTLCCmd,
+		RefundHTLCCmd,
+		GetHTLCCmd,
+		GenerateHTLCSecretCmd,
+		BurnCmd,
+		GetSupplyCmd,
+		ApproveCmd,
+		TransferFromCmd,
+		GetAllowanceCmd,
+		RegisterNameCmd,
+		RenewNameCmd,
+		TransferNameCmd,
+		ResolveNameCmd,
+		ReverseLookupCmd,
+		AnchorDigestsCmd,
+		GetAnchorCmd,
+		RotateKeyCmd,
+		GetLocalTxsCmd,
+		GetLocalTxCmd,
 	}
 	RootCmd.AddCommand(txCmds...)
 	RootSubCmdGroups["transaction"] = txCmds
 
+	for _, cmd := range []*cobra.Command{SendTransactionCmd, SimulateTransactionCmd, CreateSponsoredTransactionCmd} {
+		cmd.Flags().Uint64(validUntilFlag, 0, "The last block height that can include the transaction, 0 if the transaction does not expire")
+	}
+	for _, cmd := range []*cobra.Command{SendTransactionCmd, SimulateTransactionCmd} {
+		cmd.Flags().Bool(powFlag, false, "Solve a proof of work locally instead of paying the fees, the fees are 0 unless specified")
+	}
+
 }
 
 var SendTransactionCmd = &cobra.Command{
-	Use:     "SendTransaction {from} {to} {contract} {amount} {note} {password} {nonce}; Send a transaction;",
+	Use:     "SendTransaction {from} {to} {contract} {amount} {note} {password} {nonce} {fees} {memo} {pubkey}; Send a transaction, the receiver can be a registered @name, the memo is encrypted with the public key of the receiver;",
 	Aliases: []string{"sendtransaction", "st", "ST"},
-	Short:   "SendTransaction {from} {to} {contract} {amount} {note} {password} {nonce}; Send a transaction;",
+	Short:   "SendTransaction {from} {to} {contract} {amount} {note} {password} {nonce} {fees} {memo} {pubkey}; Send a transaction, the receiver can be a registered @name, the memo is encrypted with the public key of the receiver;",
 	Example: `
 	SendTransaction 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ UWD 10  "transaction note"
 		OR
 	SendTransaction 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ UWD 10  "transaction note" 123456
 		OR
 	SendTransaction 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ UWD 10  "transaction note" 123456 1
+		OR
+	SendTransaction 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ UWD 10  "transaction note" 123456 0 0.01
+		OR
+	SendTransaction 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ @alice UWD 10  "transaction note"
+		OR
+	SendTransaction 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ 3ajNkh7yVYkETL9JKvGx3aL2YVNrqksjCUUE UWD 10  "transaction note" 123456 0 0.01 "invoice 2020-0815"
+		OR
+	SendTransaction 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ 3ajNkh7yVYkETL9JKvGx3aL2YVNrqksjCUUE UWD 10  "transaction note" 123456 0 0.01 "invoice 2020-0815" 02c6b2a3fa0e0d4dd6dbb17fc0b7e3c23ba8bb2e8d1a4bb6d4e4f7b0b8b5e3c2a1
+		OR
+	SendTransaction 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ 3ajNkh7yVYkETL9JKvGx3aL2YVNrqksjCUUE UWD 10  "transaction note" --validuntil 11300
+		OR
+	SendTransaction 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ 3ajNkh7yVYkETL9JKvGx3aL2YVNrqksjCUUE UWD 10  "transaction note" --pow
 	`,
 	Args: cobra.MinimumNArgs(5),
 	Run:  SendTransaction,
 }
 
+var SimulateTransactionCmd = &cobra.Command{
+	Use:     "SimulateTransaction {from} {to} {contract} {amount} {note} {password} {nonce} {fees} {memo} {pubkey}; Check a transaction and get the balances after it without sending it, the arguments are the same as SendTransaction;",
+	Aliases: []string{"simulatetransaction", "smt", "SMT"},
+	Short:   "SimulateTransaction {from} {to} {contract} {amount} {note} {password} {nonce} {fees} {memo} {pubkey}; Check a transaction and get the balances after it without sending it, the arguments are the same as SendTransaction;",
+	Example: `
+	SimulateTransaction 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ 3ajNkh7yVYkETL9JKvGx3aL2YVNrqksjCUUE UWD 10  "transaction note"
+		OR
+	SimulateTransaction 3ajDJUnMYDyzXLwefRfNp7yLcdmg3ULb9ndQ 3ajNkh7yVYkETL9JKvGx3aL2YVNrqksjCUUE UWD 10  "transaction note" 123456 0 0.01
+	`,
+	Args: cobra.MinimumNArgs(5),
+	Run:  SimulateTransaction,
+}
+
+func SimulateTransaction(cmd *cobra.Command, args []string) {
+	privKey, err := readPrivate(cmd, args, 5)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	tx, err := parseParams(args)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	clearPoWFees(cmd, tx, args, 7)
+	if !fillNonce(cmd, tx) || !signTx(cmd, tx, privKey) {
+		return
+	}
+	rpcTx, err := types.TranslateTxToRpcTx(tx)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	jsonBytes, err := json.Marshal(rpcTx)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	client, err := NewRpcClient()
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Second*20)
+	defer cancel()
+	resp, err := client.Gc.SimulateTransaction(ctx, &rpc.Bytes{Bytes: jsonBytes})
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	if resp.Code == 0 {
+		output(string(resp.Result))
+		return
+	}
+	outputRespError(cmd.Use, resp)
+}
+
+var DecodeTransactionCmd = &cobra.Command{
+	Use:     "DecodeTransaction {raw}; Decode a signed raw transaction in hex and check it without sending it;",
+	Aliases: []string{"decodetransaction", "dtx", "DTX"},
+	Short:   "DecodeTransaction {raw}; Decode a signed raw transaction in hex and check it without sending it;",
+	Example: `
+	DecodeTransaction f8a6f88a80a0...
+	`,
+	Args: cobra.MinimumNArgs(1),
+	Run:  DecodeTransaction,
+}
+
+func DecodeTransaction(cmd *cobra.Command, args []string) {
+	raw, err := hex.DecodeString(args[0])
+	if err != nil {
+		log.Error(cmd.Use+" err: ", errors.New("wrong raw transaction"))
+		return
+	}
+	client, err := NewRpcClient()
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Second*20)
+	defer cancel()
+	resp, err := client.Gc.DecodeTransaction(ctx, &rpc.Bytes{Bytes: raw})
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	if resp.Code == 0 {
+		output(string(resp.Result))
+		return
+	}
+	outputRespError(cmd.Use, resp)
+}
+
 func SendTransactionByAddrRpc(args []string) (string, error) {
 	var passwd []byte
 	var err error
@@ -116,6 +258,7 @@ func SendTransaction(cmd *cobra.Command, args []string) {
 		log.Error(cmd.Use+" err: ", err)
 		return
 	}
+	clearPoWFees(cmd, tx, args, 7)
 	resp, err := GetAccountByRpc(tx.From().String())
 	if err != nil {
 		log.Error(cmd.Use+" err: ", err)
@@ -151,9 +294,13 @@ func SendTransaction(cmd *cobra.Command, args []string) {
 
 func parseParams(args []string) (*types.Transaction, error) {
 	var err error
-	var amount, nonce uint64
+	var amount, nonce, fees uint64
 	from := hasharry.StringToAddress(args[0])
-	to := hasharry.StringToAddress(args[1])
+	receiver, err := parseReceiver(args[1])
+	if err != nil {
+		return nil, err
+	}
+	to := hasharry.StringToAddress(receiver)
 	contract := hasharry.StringToAddress(args[2])
 	if fAmount, err := strconv.ParseFloat(args[3], 64); err != nil {
 		return nil, errors.New("wrong amount")
@@ -172,11 +319,130 @@ func parseParams(args []string) (*types.Transaction, error) {
 			return nil, errors.New("wrong nonce")
 		}
 	}
-	return transaction.NewTransaction(from.String(), to.String(), contract.String(), note, amount, nonce), nil
+	tx := transaction.NewTransaction(from.String(), to.String(), contract.String(), note, amount, nonce)
+	if len(args) > 7 {
+		fFees, err := strconv.ParseFloat(args[7], 64)
+		if err != nil || fFees < 0 {
+			return nil, errors.New("wrong fees")
+		}
+		if fees, err = types.NewAmount(fFees); err != nil {
+			return nil, errors.New("wrong fees")
+		}
+		tx.TxHead.Fees = fees
+	}
+	if len(args) > 8 && args[8] != "" {
+		var pubKey string
+		if len(args) > 9 {
+			pubKey = args[9]
+		}
+		if tx.TxHead.Memo, err = encryptMemo(to.String(), pubKey, args[8]); err != nil {
+			return nil, err
+		}
+	}
+	return tx, nil
+}
+
+// Fill in the nonce if it is not specified, sign the transaction and send
+// it, report whether the transaction is accepted by the node
+func sendSignedTx(cmd *cobra.Command, tx *types.Transaction, key string) bool {
+	if !fillNonce(cmd, tx) {
+		return false
+	}
+	if !signTx(cmd, tx, key) {
+		log.Error(cmd.Use+" err: ", errors.New("signature failure"))
+		return false
+	}
+
+	rs, err := sendTx(cmd, tx)
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+	} else if rs.Code != 0 {
+		log.Errorf(cmd.Use+" err: code %d, message: %s", rs.Code, rs.Err)
+	} else {
+		fmt.Println()
+		fmt.Println(string(rs.Result))
+		return true
+	}
+	return false
+}
+
+// Fill in the next nonce of the sender if it is not specified
+func fillNonce(cmd *cobra.Command, tx *types.Transaction) bool {
+	if tx.TxHead.Nonce != 0 {
+		return true
+	}
+	resp, err := GetAccountByRpc(tx.From().String())
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return false
+	}
+	if resp.Code != 0 {
+		log.Errorf(cmd.Use+" err: code %d, message: %s", resp.Code, resp.Err)
+		return false
+	}
+	var account *rpctypes.Account
+	if err := json.Unmarshal(resp.Result, &account); err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return false
+	}
+	tx.TxHead.Nonce = account.Nonce + 1
+	return true
+}
+
+// Parse the optional nonce and fees at the position of the arguments
+func parseNonceFees(tx *types.Transaction, args []string, index int) error {
+	if len(args) > index {
+		nonce, err := strconv.ParseUint(args[index], 10, 64)
+		if err != nil {
+			return errors.New("wrong nonce")
+		}
+		tx.TxHead.Nonce = nonce
+	}
+	if len(args) > index+1 {
+		fFees, err := strconv.ParseFloat(args[index+1], 64)
+		if err != nil || fFees < 0 {
+			return errors.New("wrong fees")
+		}
+		fees, err := types.NewAmount(fFees)
+		if err != nil {
+			return errors.New("wrong fees")
+		}
+		tx.TxHead.Fees = fees
+	}
+	return nil
 }
 
+// Flag of the commands whose transactions can expire
+const validUntilFlag = "validuntil"
+
+// Flag of the commands whose fees can be replaced by proof of work
+const powFlag = "pow"
+
+// A transaction with proof of work pays no fees if the fees argument
+// at the index is not given
+func clearPoWFees(cmd *cobra.Command, tx *types.Transaction, args []string, index int) {
+	if pow, err := cmd.Flags().GetBool(powFlag); err == nil && pow && len(args) <= index {
+		tx.TxHead.Fees = 0
+	}
+}
+
+// Set the hash and sign the transaction, the expiry height given by
+// the flag of the command is set first, and the proof of work is
+// solved after the hash if the flag is set
 func signTx(cmd *cobra.Command, tx *types.Transaction, key string) bool {
+	if validUntil, err := cmd.Flags().GetUint64(validUntilFlag); err == nil {
+		tx.TxHead.ValidUntil = validUntil
+	}
 	tx.SetHash()
+	if pow, err := cmd.Flags().GetBool(powFlag); err == nil && pow {
+		bits, err := getPoWBits()
+		if err != nil {
+			log.Error(cmd.Use+" err: ", err)
+			return false
+		}
+		fmt.Printf("solving proof of work of %d bits...\n", bits)
+		tx.SolvePoW(bits)
+	}
 	priv, err := secp256k1.ParseStringToPrivate(key)
 	if err != nil {
 		log.Error(cmd.Use+" err: ", errors.New("[key] wrong"))
@@ -188,6 +454,22 @@ func signTx(cmd *cobra.Command, tx *types.Transaction, key string) bool {
 	}
 	return true
 }
+// The proof of work target of the next block
+func getPoWBits() (int, error) {
+	resp, err := EstimateFeeRpc(0)
+	if err != nil {
+		return 0, err
+	}
+	if resp.Code != 0 {
+		return 0, fmt.Errorf("code %d, message: %s", resp.Code, resp.Err)
+	}
+	var fees *rpctypes.Fees
+	if err := json.Unmarshal(resp.Result, &fees); err != nil {
+		return 0, err
+	}
+	return fees.PoWBits, nil
+}
+
 func signTx1(tx *types.Transaction, key string) bool {
 	tx.SetHash()
 	priv, err := secp256k1.ParseStringToPrivate(key)
@@ -253,11 +535,13 @@ func sendTx1(tx *types.Transaction) (*rpc.Response, error) {
 }
 
 var GetTransactionCmd = &cobra.Command{
-	Use:     "GetTransaction {txhash}; Get Transaction by hash;",
+	Use:     "GetTransaction {txhash} {password}; Get Transaction by hash, the memo is decrypted with the password of the receiver keystore;",
 	Aliases: []string{"gettransaction", "gt", "GT"},
-	Short:   "GetTransaction {txhash}; Get Transaction by hash;",
+	Short:   "GetTransaction {txhash} {password}; Get Transaction by hash, the memo is decrypted with the password of the receiver keystore;",
 	Example: `
 	GetTransaction 0xef7b92e552dca02c97c9d596d1bf69d0044d95dec4cee0e6a20153e62bce893b
+		OR
+	GetTransaction 0xef7b92e552dca02c97c9d596d1bf69d0044d95dec4cee0e6a20153e62bce893b 123456
 	`,
 	Args: cobra.MinimumNArgs(1),
 	Run:  GetTransaction,
@@ -270,6 +554,14 @@ func GetTransaction(cmd *cobra.Command, args []string) {
 		return
 	}
 	if resp.Code == 0 {
+		if len(args) > 1 {
+			result, err := decryptMemo(resp.Result, []byte(args[1]))
+			if err != nil {
+				log.Error(cmd.Use+" err: ", err)
+				return
+			}
+			resp.Result = result
+		}
 		output(string(resp.Result))
 		return
 	}
@@ -290,6 +582,72 @@ func GetTransactionRpc(hashStr string) (*rpc.Response, error) {
 	return resp, err
 }
 
+var GetLocalTxsCmd = &cobra.Command{
+	Use:     "GetLocalTxs; Get the status of the transactions sent by the node;",
+	Aliases: []string{"getlocaltxs", "glts", "GLTS"},
+	Short:   "GetLocalTxs; Get the status of the transactions sent by the node;",
+	Example: `
+	GetLocalTxs
+	`,
+	Args: cobra.MinimumNArgs(0),
+	Run:  GetLocalTxs,
+}
+
+func GetLocalTxs(cmd *cobra.Command, args []string) {
+	client, err := NewRpcClient()
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Second*20)
+	defer cancel()
+	resp, err := client.Gc.GetLocalTxs(ctx, &rpc.Null{})
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	if resp.Code == 0 {
+		output(string(resp.Result))
+		return
+	}
+	outputRespError(cmd.Use, resp)
+}
+
+var GetLocalTxCmd = &cobra.Command{
+	Use:     "GetLocalTx {txhash}; Get the status of a transaction sent by the node;",
+	Aliases: []string{"getlocaltx", "glt", "GLT"},
+	Short:   "GetLocalTx {txhash}; Get the status of a transaction sent by the node;",
+	Example: `
+	GetLocalTx 0xef7b92e552dca02c97c9d596d1bf69d0044d95dec4cee0e6a20153e62bce893b
+	`,
+	Args: cobra.MinimumNArgs(1),
+	Run:  GetLocalTx,
+}
+
+func GetLocalTx(cmd *cobra.Command, args []string) {
+	client, err := NewRpcClient()
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Second*20)
+	defer cancel()
+	resp, err := client.Gc.GetLocalTx(ctx, &rpc.Hash{Hash: args[0]})
+	if err != nil {
+		log.Error(cmd.Use+" err: ", err)
+		return
+	}
+	if resp.Code == 0 {
+		output(string(resp.Result))
+		return
+	}
+	outputRespError(cmd.Use, resp)
+}
+
 func SendTransactionRpc(tx string) (*rpc.Response, error) {
 
 	rpcClient, err := NewRpcClient()
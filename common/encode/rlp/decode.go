@@ -438,9 +438,18 @@ func makeStructDecoder(typ reflect.Type) (decoder, error) {
 		if _, err := s.List(); err != nil {
 			return wrapStreamError(err, typ)
 		}
-		for _, f := range fields {
+		for i, f := range fields {
 			err := f.info.decoder(s, val.Field(f.index))
 			if err == EOL {
+				if f.optional {
+					// The remaining fields are optional too, reset them
+					// to their zero values.
+					for _, rest := range fields[i:] {
+						rv := val.Field(rest.index)
+						rv.Set(reflect.Zero(rv.Type()))
+					}
+					break
+				}
 				return &decodeError{msg: "too few elements", typ: typ}
 			} else if err != nil {
 				return addErrorContext(err, "."+typ.Field(f.index).Name)
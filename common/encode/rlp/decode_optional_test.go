@@ -0,0 +1,33 @@
+package rlp
+
+import (
+	"bytes"
+	"fmt"
+)
+
+type structWithOptional struct {
+	A, B uint
+	C    uint  `rlp:"optional"`
+	D    *uint `rlp:"optional"`
+}
+
+func ExampleDecode_structTagOptional() {
+	// In this example, the "optional" struct tag is used to decode lists
+	// written before the optional fields were added.
+	var val structWithOptional
+
+	err := Decode(bytes.NewReader([]byte{0xC2, 0x01, 0x02}), &val)
+	fmt.Printf("with 2 elements: err=%v val=%v\n", err, val)
+
+	err = Decode(bytes.NewReader([]byte{0xC3, 0x01, 0x02, 0x03}), &val)
+	fmt.Printf("with 3 elements: err=%v val=%v\n", err, val)
+
+	// Zero values of the trailing optional fields are not written
+	enc, _ := EncodeToBytes(structWithOptional{A: 1, B: 2})
+	fmt.Printf("encoded: %X\n", enc)
+
+	// Output:
+	// with 2 elements: err=<nil> val={1 2 0 <nil>}
+	// with 3 elements: err=<nil> val={1 2 3 <nil>}
+	// encoded: C20102
+}
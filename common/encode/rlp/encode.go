@@ -530,8 +530,15 @@ func makeStructWriter(typ reflect.Type) (writer, error) {
 		return nil, err
 	}
 	writer := func(val reflect.Value, w *encbuf) error {
+		// Trailing optional fields with zero values are not written
+		last := len(fields) - 1
+		for ; last >= 0 && fields[last].optional; last-- {
+			if !val.Field(fields[last].index).IsZero() {
+				break
+			}
+		}
 		lh := w.list()
-		for _, f := range fields {
+		for _, f := range fields[:last+1] {
 			if err := f.info.writer(val.Field(f.index), w); err != nil {
 				return err
 			}
@@ -81,6 +81,35 @@ func PrivateToJson(net string, priv *secp256k1.PrivateKey, mnemonicStr string, p
 	return j, nil
 }
 
+// Replace the key of the keystore of the address with the rotated
+// key. The address is kept, the previous keystore is backed up.
+func RotateKeyJson(net string, dir string, address string, private *secp256k1.PrivateKey, mnemonicStr string, passWd []byte) (*Json, error) {
+	path := dir + "/" + address + ".json"
+	if !utils.IsExist(path) {
+		return nil, fmt.Errorf("keystore of %s does not exist", address)
+	}
+	old, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(path+".bak", old, os.ModePerm); err != nil {
+		return nil, err
+	}
+	j, err := PrivateToJson(net, private, mnemonicStr, passWd)
+	if err != nil {
+		return nil, err
+	}
+	j.Address = address
+	bytes, err := json.Marshal(j)
+	if err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(path, bytes, os.ModePerm); err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
 func ReadAllAccount(dir string) ([]string, error) {
 	accountList, err := utils.ReadLine(dir + boot)
 	if err != nil {
@@ -50,6 +50,15 @@ type Config struct {
 	KeyPass     string `long:"keypass" description:"The decryption password for key file"`
 	FallBackTo  int64  `long:"fallbackto" description:"Force back to a height"`
 	Version     bool   `long:"version" description:"View Version number"`
+
+	// Transaction pool settings, 0 or empty uses the default
+	TxPoolCapacity   int    `long:"txpoolcapacity" description:"Maximum number of transactions in the transaction pool (default = 50000)"`
+	TxPoolMaxBytes   uint64 `long:"txpoolmaxbytes" description:"Maximum total size in bytes of the transactions in the transaction pool (default = 33554432)"`
+	TxPoolAccountTxs int    `long:"txpoolaccounttxs" description:"Maximum number of transactions of an address in the transaction pool (default = 1000)"`
+	TxPoolLifeTime   int64  `long:"txpoollifetime" description:"Seconds a transaction can stay in the transaction pool (default = 10800)"`
+	TxPoolMonitor    int64  `long:"txpoolmonitor" description:"Interval in seconds of clearing the expired transactions of the transaction pool (default = 20)"`
+	TxPoolEviction   string `long:"txpooleviction" description:"Strategy of evicting the ready transactions when the transaction pool is full, oldest or feerate (default = oldest)"`
+
 	NodePrivate *NodePrivate
 }
 
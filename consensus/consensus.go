@@ -93,6 +93,9 @@ type IChain interface {
 	// Get the number of votes cast by the address
 	GetAddressVote(address hasharry.Address) uint64
 
+	// Verify that the public key controls the address
+	VerifySigner(address hasharry.Address, pubKey []byte) bool
+
 	// Get the hash of the last block of the previous cycle
 	GetTermLastHash(term uint64) (hasharry.Hash, error)
 
@@ -238,7 +238,7 @@ func (dpos *DPos) VerifyCreator(header *types.Header, parent *types.Header, chai
 	if err != nil {
 		return err
 	}
-	if err := dpos.verifyBlockSigner(signer, header); err != nil {
+	if err := dpos.verifyBlockSigner(chain, signer, header); err != nil {
 		return err
 	}
 	return nil
@@ -470,8 +470,8 @@ func (dpos *DPos) loadConfirmedBlockHeader(chain consensus.IChain) (*types.Heade
 	return header, nil
 }
 
-func (dpos *DPos) verifyBlockSigner(winner hasharry.Address, header *types.Header) error {
-	if !types.VerifySigner(param.Net, winner, header.SignScript.PubKey) {
+func (dpos *DPos) verifyBlockSigner(chain consensus.IChain, winner hasharry.Address, header *types.Header) error {
+	if !chain.VerifySigner(winner, header.SignScript.PubKey) {
 		return errors.New("not the signature of the address")
 	}
 	if !types.Verify(header.Hash, header.SignScript) {
@@ -20,8 +20,12 @@ type BlockChain struct {
 	stateRoot     hasharry.Hash
 	contractRoot  hasharry.Hash
 	consensusRoot hasharry.Hash
+	htlcRoot      hasharry.Hash
+	nameRoot      hasharry.Hash
 	accountState  IAccountState
 	contractState IContractState
+	htlcState     IHTLCState
+	nameState     INameState
 	consensus     consensus.IConsensus
 	storage       IBlockChainStorage
 	mutex         sync.RWMutex
@@ -36,7 +40,7 @@ type BlockChain struct {
 }
 
 func NewBlockChain(dataDir string, consensus consensus.IConsensus, stateUpdateCh chan struct{},
-	removeTxsCh chan types.Transactions, accountState IAccountState, contractState IContractState) (*BlockChain, error) {
+	removeTxsCh chan types.Transactions, accountState IAccountState, contractState IContractState, htlcState IHTLCState, nameState INameState) (*BlockChain, error) {
 	blockChain := &BlockChain{}
 	storage := blcdb.NewBlockChainStorage(dataDir + "/" + blockChainStorage)
 	err := storage.Open()
@@ -47,6 +51,8 @@ func NewBlockChain(dataDir string, consensus consensus.IConsensus, stateUpdateCh
 	blockChain.storage = storage
 	blockChain.accountState = accountState
 	blockChain.contractState = contractState
+	blockChain.htlcState = htlcState
+	blockChain.nameState = nameState
 	blockChain.stateUpdateCh = stateUpdateCh
 	blockChain.consensus = consensus
 	blockChain.removeTxsCh = removeTxsCh
@@ -64,6 +70,20 @@ func NewBlockChain(dataDir string, consensus consensus.IConsensus, stateUpdateCh
 	}
 	blockChain.contractRoot = blockChain.contractState.RootHash()
 
+	htlcRoot, _ := blockChain.storage.GetHTLCRoot()
+	err = blockChain.htlcState.InitTrie(htlcRoot)
+	if err != nil {
+		return nil, err
+	}
+	blockChain.htlcRoot = blockChain.htlcState.RootHash()
+
+	nameRoot, _ := blockChain.storage.GetNameRoot()
+	err = blockChain.nameState.InitTrie(nameRoot)
+	if err != nil {
+		return nil, err
+	}
+	blockChain.nameRoot = blockChain.nameState.RootHash()
+
 	consensusRoot, _ := blockChain.storage.GetConsensusRoot()
 	err = blockChain.consensus.InitTrie(consensusRoot)
 	if err != nil {
@@ -104,8 +124,13 @@ func (blc *BlockChain) UpdateConfirmedHeight(height uint64) {
 	blc.mutex.Lock()
 	defer blc.mutex.Unlock()
 
+	// Time-locked amounts are unlocked by the time of the confirmed block
+	var blockTime uint64
+	if header, err := blc.storage.GetHeaderByHeight(height); err == nil {
+		blockTime = header.Time
+	}
 	blc.confirmedHeight = height
-	blc.accountState.UpdateConfirmedHeight(height)
+	blc.accountState.UpdateConfirmedHeight(height, blockTime)
 }
 
 func (blc *BlockChain) GetLastHeight() uint64 {
@@ -173,6 +198,69 @@ func (blc *BlockChain) GetTransactionIndex(hash hasharry.Hash) (types.ITransacti
 	return txIndex, nil
 }
 
+// Get the earliest anchor of the digest on the chain and the
+// proof of the anchor transaction against the block header
+func (blc *BlockChain) GetAnchor(digest hasharry.Hash) (*types.Anchor, error) {
+	blc.mutex.RLock()
+	defer blc.mutex.RUnlock()
+
+	return blc.getAnchor(digest)
+}
+
+func (blc *BlockChain) getAnchor(digest hasharry.Hash) (*types.Anchor, error) {
+	txHash, err := blc.storage.GetAnchor(digest)
+	if err != nil {
+		return nil, fmt.Errorf("digest %s is not anchored", digest.String())
+	}
+	txLoc, err := blc.storage.GetTxLocation(txHash)
+	if err != nil {
+		return nil, err
+	}
+	// The block of the anchor may have been rolled back
+	if txLoc.Height > blc.currentHeight {
+		return nil, fmt.Errorf("digest %s is not anchored", digest.String())
+	}
+	header, err := blc.storage.GetHeaderByHeight(txLoc.Height)
+	if err != nil {
+		return nil, err
+	}
+	if !header.TxRoot.IsEqual(txLoc.TxRoot) {
+		return nil, fmt.Errorf("digest %s is not anchored", digest.String())
+	}
+	rlpTxs, err := blc.storage.GetTransactions(header.TxRoot)
+	if err != nil {
+		return nil, err
+	}
+	body := (&types.RlpBody{Transactions: rlpTxs}).TranslateToBody()
+	return &types.Anchor{
+		Digest: digest,
+		TxHash: txHash,
+		Header: header,
+		Proof:  types.NewTxProof(body.Transactions, txLoc.TxIndex),
+	}, nil
+}
+
+// Get the public key of the address from the latest transaction
+// signed by the address on the chain
+func (blc *BlockChain) GetPubKey(address hasharry.Address) ([]byte, error) {
+	blc.mutex.RLock()
+	defer blc.mutex.RUnlock()
+
+	return blc.storage.GetPubKey(address)
+}
+
+// Index the digests anchored in the block, a digest that has
+// already been anchored keeps the earliest anchor
+func (blc *BlockChain) updateAnchors(block *types.Block) {
+	anchors := block.GetAnchors()
+	for digest := range anchors {
+		if _, err := blc.getAnchor(digest); err == nil {
+			delete(anchors, digest)
+		}
+	}
+	blc.storage.UpdateAnchors(anchors)
+}
+
 func (blc *BlockChain) GetRlpBlockByHeight(height uint64) (*types.RlpBlock, error) {
 	header, err := blc.storage.GetHeaderByHeight(height)
 	if err != nil {
@@ -206,10 +294,29 @@ func (blc *BlockChain) GetAddressVote(address hasharry.Address) uint64 {
 	return vote
 }
 
+// Verify that the public key controls the address, taking the
+// key bound by a key rotation into account.
+func (blc *BlockChain) VerifySigner(address hasharry.Address, pubKey []byte) bool {
+	return blc.accountState.GetAccountState(address).VerifySigner(address, pubKey)
+}
+
 func (blc *BlockChain) GetTermLastHash(term uint64) (hasharry.Hash, error) {
 	return blc.storage.GetTermLastHash(term)
 }
 
+// The minimum fee required by the transactions of the next block
+func (blc *BlockChain) GetMinFees() uint64 {
+	return blc.getMinFees(blc.GetLastHeight() + 1)
+}
+
+func (blc *BlockChain) getMinFees(height uint64) uint64 {
+	fees, err := blc.storage.GetMinFees(height)
+	if err != nil {
+		return param.Fees
+	}
+	return fees
+}
+
 func (blc *BlockChain) InsertChain(block *types.Block) error {
 	if _, err := blc.GetBlockByHeight(block.Height - 1); err != nil {
 		return err
@@ -224,15 +331,22 @@ func (blc *BlockChain) saveBlock(block *types.Block) error {
 	blc.storage.UpdateHeader(block.Header)
 	blc.storage.UpdateTransactions(block.TxRoot, block.Body.TranslateToRlpBody().Transactions)
 	blc.storage.UpdateTxLocation(block.GetTxsLocations())
+	blc.updateAnchors(block)
+	blc.storage.UpdatePubKeys(block.GetPubKeys())
 	blc.storage.UpdateHeightHash(block.Height, block.Hash)
 	blc.storage.UpdateHistoryConfirmedHeight(block.Height, blc.confirmedHeight)
 	blc.storage.UpdateTermLastHash(block.Term, block.Hash)
+	blc.storage.UpdateMinFees(block.Height+1, CalMinFees(blc.getMinFees(block.Height), block.Transactions.Len()-1))
 	blc.stateRoot, _ = blc.accountState.StateTrieCommit()
 	blc.contractRoot, _ = blc.contractState.ContractTrieCommit()
 	blc.consensusRoot, _ = blc.consensus.Commit()
+	blc.htlcRoot, _ = blc.htlcState.HTLCTrieCommit()
+	blc.nameRoot, _ = blc.nameState.NameTrieCommit()
 	blc.storage.UpdateStateRoot(blc.stateRoot)
 	blc.storage.UpdateContractRoot(blc.contractRoot)
 	blc.storage.UpdateConsensusRoot(blc.consensusRoot)
+	blc.storage.UpdateHTLCRoot(blc.htlcRoot)
+	blc.storage.UpdateNameRoot(blc.nameRoot)
 
 	blc.currentHeight = block.Height
 	blc.storage.UpdateLastHeight(block.Height)
@@ -276,7 +390,7 @@ func (blc *BlockChain) SaveGenesisBlock(block *types.Block) error {
 func (blc *BlockChain) updateState(block *types.Block) error {
 	for _, tx := range block.Body.Transactions {
 		switch tx.GetTxType() {
-		case types.NormalTransaction:
+		case types.NormalTransaction, types.TimeLockTransaction:
 			if err := blc.accountState.UpdateFrom(tx, block.Height); err != nil {
 				return err
 			}
@@ -290,7 +404,77 @@ func (blc *BlockChain) updateState(block *types.Block) error {
 			if err := blc.accountState.UpdateTo(tx, block.Height); err != nil {
 				return err
 			}
-			blc.contractState.UpdateContract(tx, block.Height)
+			if err := blc.contractState.UpdateContract(tx, block.Height); err != nil {
+				return err
+			}
+		case types.ApproveTransaction:
+			if err := blc.accountState.UpdateFrom(tx, block.Height); err != nil {
+				return err
+			}
+		case types.TransferFromTransaction:
+			if err := blc.accountState.UpdateFrom(tx, block.Height); err != nil {
+				return err
+			}
+			if err := blc.accountState.UpdateOwner(tx, block.Height); err != nil {
+				return err
+			}
+			if err := blc.accountState.UpdateTo(tx, block.Height); err != nil {
+				return err
+			}
+		case types.ContractPolicyTransaction:
+			if err := blc.accountState.UpdateFrom(tx, block.Height); err != nil {
+				return err
+			}
+			if err := blc.contractState.UpdatePolicy(tx, block.Height); err != nil {
+				return err
+			}
+		case types.ContractMetadataTransaction, types.ContractIssuerTransaction:
+			if err := blc.accountState.UpdateFrom(tx, block.Height); err != nil {
+				return err
+			}
+			if err := blc.contractState.UpdateContractInfo(tx, block.Height); err != nil {
+				return err
+			}
+		case types.BurnTransaction:
+			if err := blc.accountState.UpdateFrom(tx, block.Height); err != nil {
+				return err
+			}
+			if tx.GetTxBody().GetContract().IsEqual(param.Token) {
+				if err := blc.accountState.UpdateBurned(tx, block.Height); err != nil {
+					return err
+				}
+			} else {
+				blc.contractState.UpdateBurn(tx, block.Height)
+			}
+		case types.AnchorTransaction, types.KeyRotationTransaction:
+			if err := blc.accountState.UpdateFrom(tx, block.Height); err != nil {
+				return err
+			}
+		case types.HTLCLockTransaction:
+			if err := blc.accountState.UpdateFrom(tx, block.Height); err != nil {
+				return err
+			}
+			if err := blc.htlcState.Lock(tx, block.Height); err != nil {
+				return err
+			}
+		case types.HTLCClaimTransaction, types.HTLCRefundTransaction:
+			if err := blc.accountState.UpdateFrom(tx, block.Height); err != nil {
+				return err
+			}
+			htlc, err := blc.htlcState.Settle(tx, block.Height)
+			if err != nil {
+				return err
+			}
+			if err := blc.accountState.UpdateReceive(htlc.Receiver(tx), htlc.Contract, htlc.Amount, block.Height); err != nil {
+				return err
+			}
+		case types.NameRegisterTransaction, types.NameRenewTransaction, types.NameTransferTransaction:
+			if err := blc.accountState.UpdateFrom(tx, block.Height); err != nil {
+				return err
+			}
+			if err := blc.nameState.UpdateName(tx, block.Height); err != nil {
+				return err
+			}
 			/*case types.VoteToCandidate:
 				fallthrough
 			case types.LoginCandidate:
@@ -357,6 +541,12 @@ func (blc *BlockChain) verifyBlock(block *types.Block) error {
 	if block.Height <= blc.GetLastHeight() {
 		return ErrDuplicateBlock
 	}
+	if block.Transactions.Len() > param.MaxBlockTransactions+1 {
+		return ErrTooManyTxs
+	}
+	if block.Transactions.Size() > param.MaxBlockSize {
+		return ErrBlockTooLarge
+	}
 	if !block.VerifyTxRoot() {
 		log.Warn("tx root wrong", "height", block.Header.Height, "tx root", block.Header.StateRoot.String())
 		return errors.New("wrong tx root")
@@ -374,6 +564,14 @@ func (blc *BlockChain) verifyBlock(block *types.Block) error {
 		log.Warn("consensus root wrong", "height", block.Header.Height, "consensus root", block.Header.ConsensusRoot.String())
 		return errors.New("wrong consensus root")
 	}
+	if !block.HTLCRoot.IsEqual(blc.HTLCRoot()) {
+		log.Warn("htlc root wrong", "height", block.Header.Height, "htlc root", block.Header.HTLCRoot.String())
+		return errors.New("wrong htlc root")
+	}
+	if !block.NameRoot.IsEqual(blc.NameRoot()) {
+		log.Warn("name root wrong", "height", block.Header.Height, "name root", block.Header.NameRoot.String())
+		return errors.New("wrong name root")
+	}
 	if err := blc.verifyTxs(block.Transactions, block.Height); err != nil {
 		return err
 	}
@@ -395,6 +593,10 @@ func (blc *BlockChain) verifyTx(tx types.ITransaction, blockHeight uint64) error
 		return err
 	}
 
+	if tx.IsExpired(blockHeight) {
+		return types.ErrTxExpired
+	}
+
 	if err := blc.consensus.VerifyTx(tx); err != nil {
 		return err
 	}
@@ -407,41 +609,63 @@ func (blc *BlockChain) verifyTx(tx types.ITransaction, blockHeight uint64) error
 		return err
 	}
 
-	if err := blc.verifyBusiness(tx, blockHeight); err != nil {
+	if err := blc.htlcState.VerifyState(tx, blockHeight); err != nil {
+		return err
+	}
+
+	if err := blc.nameState.VerifyState(tx, blockHeight); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (blc *BlockChain) verifyBusiness(tx types.ITransaction, blockHeight uint64) error {
-	switch tx.GetTxType() {
-	case types.NormalTransaction:
-		account := blc.accountState.GetAccountState(tx.From())
-		return account.VerifyNonce(tx.GetNonce())
+// Verify the nonce of the transaction. An address can send several
+// transactions in one block, but their nonce values must be continuous.
+func (blc *BlockChain) verifyBusiness(tx types.ITransaction, nonces map[string]uint64) error {
+	from := tx.From().String()
+	if nonce, ok := nonces[from]; ok {
+		if tx.GetNonce() != nonce+1 {
+			return fmt.Errorf("the nonce value must be %d", nonce+1)
+		}
+		return nil
 	}
-	return nil
+	account := blc.accountState.GetAccountState(tx.From())
+	return account.VerifyNonce(tx.GetNonce())
 }
 
 func (blc *BlockChain) verifyTxs(txs types.Transactions, blockHeight uint64) error {
-	address := make(map[string]bool)
+	var hasCoinBase bool
+	nonces := make(map[string]uint64)
+	// The keys bound by the key rotations before the transaction
+	rotations := make(types.Rotations)
+	minFees := blc.getMinFees(blockHeight)
 	for _, tx := range txs {
 		if tx.IsCoinBase() {
-			if err := blc.verifyCoinBaseTx(tx, blockHeight, 0); err != nil {
-				return err
+			if hasCoinBase {
+				return errors.New("a block can only have one coinbase transaction")
 			}
-		} else {
-			if err := blc.verifyTx(tx, blockHeight); err != nil {
-				blc.removeTxsCh <- types.Transactions{tx}
+			hasCoinBase = true
+			if err := blc.verifyCoinBaseTx(tx, blockHeight, 0); err != nil {
 				return err
 			}
+			continue
+		}
+		if err := blc.verifyTx(tx, blockHeight); err != nil {
+			blc.removeTxsCh <- types.Transactions{tx}
+			return err
+		}
+		if err := tx.VerifyMinFees(minFees); err != nil {
+			return fmt.Errorf("transaction %s: %s", tx.Hash().String(), err.Error())
 		}
-		from := tx.From().String()
-		if _, ok := address[from]; !ok {
-			address[from] = true
-		} else {
-			return errors.New("one address in a block can only send one transaction")
+		if err := rotations.VerifySigners(tx); err != nil {
+			return fmt.Errorf("transaction %s: %s", tx.Hash().String(), err.Error())
+		}
+		if err := blc.verifyBusiness(tx, nonces); err != nil {
+			return err
 		}
+		nonces[tx.From().String()] = tx.GetNonce()
+		rotations.Add(tx)
 	}
 	return nil
 }
@@ -492,7 +716,7 @@ func (blc *BlockChain) FallBackTo(height uint64) error {
 	defer blc.mutex.Unlock()
 
 	blc.confirmedHeight = hisConfirmedHeight
-	blc.accountState.UpdateConfirmedHeight(hisConfirmedHeight)
+	blc.accountState.UpdateConfirmedHeight(hisConfirmedHeight, hisHeader.Time)
 
 	// fall back to pre state root
 	curStateRoot = header.StateRoot
@@ -521,6 +745,22 @@ func (blc *BlockChain) FallBackTo(height uint64) error {
 	}
 	blc.consensusRoot = blc.consensus.RootHash()
 
+	// fall back to htlc root
+	err = blc.htlcState.InitTrie(header.HTLCRoot)
+	if err != nil {
+		log.Error("Fall back to block height", "height", height, "error", "init htlc trie failed")
+		return fmt.Errorf("fall back to block height %d failed! init htlc trie failed", height)
+	}
+	blc.htlcRoot = blc.htlcState.RootHash()
+
+	// fall back to name root
+	err = blc.nameState.InitTrie(header.NameRoot)
+	if err != nil {
+		log.Error("Fall back to block height", "height", height, "error", "init name trie failed")
+		return fmt.Errorf("fall back to block height %d failed! init name trie failed", height)
+	}
+	blc.nameRoot = blc.nameState.RootHash()
+
 	blc.currentHeight = curBlockHeight
 	blc.storage.UpdateLastHeight(curBlockHeight)
 	return nil
@@ -565,11 +805,25 @@ func (blc *BlockChain) ConsensusRoot() hasharry.Hash {
 	return blc.consensusRoot
 }
 
-func (blc *BlockChain) TireRoot() (hasharry.Hash, hasharry.Hash, hasharry.Hash) {
+func (blc *BlockChain) HTLCRoot() hasharry.Hash {
+	blc.mutex.RLock()
+	defer blc.mutex.RUnlock()
+
+	return blc.htlcRoot
+}
+
+func (blc *BlockChain) NameRoot() hasharry.Hash {
+	blc.mutex.RLock()
+	defer blc.mutex.RUnlock()
+
+	return blc.nameRoot
+}
+
+func (blc *BlockChain) TireRoot() (hasharry.Hash, hasharry.Hash, hasharry.Hash, hasharry.Hash, hasharry.Hash) {
 	blc.mutex.RLock()
 	defer blc.mutex.RUnlock()
 
-	return blc.stateRoot, blc.contractRoot, blc.consensusRoot
+	return blc.stateRoot, blc.contractRoot, blc.consensusRoot, blc.htlcRoot, blc.nameRoot
 }
 
 func (blc *BlockChain) CloseStorage() error {
@@ -578,6 +832,8 @@ func (blc *BlockChain) CloseStorage() error {
 
 	var err error
 	err = blc.contractState.Close()
+	err = blc.htlcState.Close()
+	err = blc.nameState.Close()
 	err = blc.accountState.Close()
 	err = blc.consensus.Close()
 	err = blc.storage.Close()
@@ -5,4 +5,6 @@ import "errors"
 var (
 	ErrDuplicateBlock = errors.New("duplicate block")
 	ErrNoParent       = errors.New("not find block parent header")
+	ErrTooManyTxs     = errors.New("too many transactions in the block")
+	ErrBlockTooLarge  = errors.New("the transactions of the block are too large")
 )
@@ -0,0 +1,29 @@
+package core
+
+import "github.com/uworldao/UWORLD/param"
+
+// Calculate the minimum fee of the next block. If the number of transactions
+// in the block exceeds the target, the minimum fee rises, otherwise it falls,
+// the change of each block does not exceed 1/FeesChangeDenominator.
+func CalMinFees(minFees uint64, txCount int) uint64 {
+	target := uint64(param.TargetBlockTransactions)
+	count := uint64(txCount)
+	switch {
+	case count > target:
+		delta := minFees * (count - target) / target / param.FeesChangeDenominator
+		if delta == 0 {
+			delta = 1
+		}
+		minFees += delta
+	case count < target:
+		minFees -= minFees * (target - count) / target / param.FeesChangeDenominator
+	}
+
+	if minFees < param.Fees {
+		return param.Fees
+	}
+	if minFees > param.MaxFees {
+		return param.MaxFees
+	}
+	return minFees
+}
@@ -18,11 +18,17 @@ type IAccountState interface {
 
 	UpdateTo(tx types.ITransaction, blockHeight uint64) error
 
+	UpdateReceive(address, contract hasharry.Address, amount, blockHeight uint64) error
+
+	UpdateOwner(tx types.ITransaction, blockHeight uint64) error
+
 	UpdateFees(fees, blockHeight uint64) error
 
 	UpdateConsumption(consumption, blockHeight uint64) error
 
-	UpdateConfirmedHeight(height uint64)
+	UpdateBurned(tx types.ITransaction, blockHeight uint64) error
+
+	UpdateConfirmedHeight(height, blockTime uint64)
 
 	VerifyState(tx types.ITransaction) error
 
@@ -28,10 +28,18 @@ type IBlockChain interface {
 
 	GetTransactionIndex(hash hasharry.Hash) (types.ITransactionIndex, error)
 
+	GetAnchor(digest hasharry.Hash) (*types.Anchor, error)
+
+	GetPubKey(address hasharry.Address) ([]byte, error)
+
 	GetAddressVote(address hasharry.Address) uint64
 
+	VerifySigner(address hasharry.Address, pubKey []byte) bool
+
 	GetTermLastHash(term uint64) (hasharry.Hash, error)
 
+	GetMinFees() uint64
+
 	InsertChain(block *types.Block) error
 
 	SaveGenesisBlock(block *types.Block) error
@@ -52,7 +60,11 @@ type IBlockChain interface {
 
 	ConsensusRoot() hasharry.Hash
 
-	TireRoot() (hasharry.Hash, hasharry.Hash, hasharry.Hash)
+	HTLCRoot() hasharry.Hash
+
+	NameRoot() hasharry.Hash
+
+	TireRoot() (hasharry.Hash, hasharry.Hash, hasharry.Hash, hasharry.Hash, hasharry.Hash)
 
 	CloseStorage() error
 }
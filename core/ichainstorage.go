@@ -18,16 +18,26 @@ type IBlockChainStorage interface {
 
 	GetTxLocation(hash hasharry.Hash) (*types.TxLocation, error)
 
+	GetAnchor(digest hasharry.Hash) (hasharry.Hash, error)
+
+	GetPubKey(address hasharry.Address) ([]byte, error)
+
 	GetStateRoot() (hasharry.Hash, error)
 
 	GetContractRoot() (hasharry.Hash, error)
 
 	GetConsensusRoot() (hasharry.Hash, error)
 
+	GetHTLCRoot() (hasharry.Hash, error)
+
+	GetNameRoot() (hasharry.Hash, error)
+
 	GetHistoryConfirmedHeight(height uint64) (uint64, error)
 
 	GetTermLastHash(term uint64) (hasharry.Hash, error)
 
+	GetMinFees(height uint64) (uint64, error)
+
 	UpdateLastHeight(height uint64)
 
 	UpdateHeader(header *types.Header)
@@ -36,6 +46,10 @@ type IBlockChainStorage interface {
 
 	UpdateTxLocation(txLocs map[hasharry.Hash]*types.TxLocation)
 
+	UpdateAnchors(anchors map[hasharry.Hash]hasharry.Hash)
+
+	UpdatePubKeys(pubKeys map[hasharry.Address][]byte)
+
 	UpdateHeightHash(height uint64, hash hasharry.Hash)
 
 	UpdateStateRoot(hash hasharry.Hash)
@@ -44,9 +58,15 @@ type IBlockChainStorage interface {
 
 	UpdateConsensusRoot(hash hasharry.Hash)
 
+	UpdateHTLCRoot(hash hasharry.Hash)
+
+	UpdateNameRoot(hash hasharry.Hash)
+
 	UpdateHistoryConfirmedHeight(height uint64, confirmedHeight uint64)
 
 	UpdateTermLastHash(term uint64, hash hasharry.Hash)
 
+	UpdateMinFees(height uint64, fees uint64)
+
 	Close() error
 }
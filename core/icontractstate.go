@@ -10,7 +10,13 @@ type IContractState interface {
 
 	VerifyState(tx types.ITransaction) error
 
-	UpdateContract(tx types.ITransaction, blockHeight uint64)
+	UpdateContract(tx types.ITransaction, blockHeight uint64) error
+
+	UpdateBurn(tx types.ITransaction, blockHeight uint64)
+
+	UpdatePolicy(tx types.ITransaction, blockHeight uint64) error
+
+	UpdateContractInfo(tx types.ITransaction, blockHeight uint64) error
 
 	UpdateConfirmedHeight(height uint64)
 
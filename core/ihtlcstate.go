@@ -0,0 +1,24 @@
+package core
+
+import (
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/core/types"
+)
+
+type IHTLCState interface {
+	GetHTLC(id hasharry.Hash) *types.HTLC
+
+	VerifyState(tx types.ITransaction, height uint64) error
+
+	Lock(tx types.ITransaction, blockHeight uint64) error
+
+	Settle(tx types.ITransaction, blockHeight uint64) (*types.HTLC, error)
+
+	InitTrie(hash hasharry.Hash) error
+
+	RootHash() hasharry.Hash
+
+	HTLCTrieCommit() (hasharry.Hash, error)
+
+	Close() error
+}
@@ -0,0 +1,24 @@
+package core
+
+import (
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/core/types"
+)
+
+type INameState interface {
+	ResolveName(name string, height uint64) (*types.Name, error)
+
+	ReverseLookup(owner hasharry.Address, height uint64) []*types.Name
+
+	VerifyState(tx types.ITransaction, height uint64) error
+
+	UpdateName(tx types.ITransaction, blockHeight uint64) error
+
+	InitTrie(hash hasharry.Hash) error
+
+	RootHash() hasharry.Hash
+
+	NameTrieCommit() (hasharry.Hash, error)
+
+	Close() error
+}
@@ -1,15 +1,130 @@
 package core
 
-import "github.com/uworldao/UWORLD/core/types"
+import (
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/core/types"
+)
+
+// Status of the transactions submitted by the local node
+const (
+	LocalTxPending  = "pending"
+	LocalTxFuture   = "future"
+	LocalTxIncluded = "included"
+	LocalTxDropped  = "dropped"
+)
 
 // Transaction pool interface, which is used to manage the transaction pool
 type ITxPool interface {
 	Start() error
 	Stop() error
 	Add(tx types.ITransaction, isPeer bool) error
-	Gets(count int) types.Transactions
+	GetBlockTemplate(maxCount int, maxSize uint64) *BlockTemplate
+	EstimateFees(size uint64) uint64
 	GetAll() (types.Transactions, types.Transactions)
+	GetLocalTxs() []*LocalTxStatus
+	GetLocalTx(hash hasharry.Hash) (*LocalTxStatus, error)
+	GetAddressTxs(address hasharry.Address) *AddressTxs
+	GetStatus() *TxPoolStatus
+	GetRejectedTxs() []*RejectedTx
+	Simulate(tx types.ITransaction) *TxSimulation
 	Get() types.ITransaction
 	Remove(txs types.Transactions)
+	RemoveRejected(rejected []*RejectedTx)
 	IsExist(tx types.ITransaction) bool
 }
+
+// Status of a transaction submitted by the local node
+type LocalTxStatus struct {
+	Tx     types.ITransaction
+	Status string
+	Reason string
+	Height uint64
+}
+
+// Transactions of an address in the pool
+type AddressTxs struct {
+	Address hasharry.Address
+	// Nonce of the address in the account state
+	Nonce    uint64
+	Prepared types.Transactions
+	Future   types.Transactions
+	// Missing nonce values that keep the future transactions
+	// from being packaged
+	Gaps []*NonceGap
+}
+
+// A continuous range of missing nonce values
+type NonceGap struct {
+	From uint64
+	To   uint64
+}
+
+// Summary of the transactions in the pool
+type TxPoolStatus struct {
+	Prepared int
+	Future   int
+	Local    int
+	Rejected int
+	// Total size of the transactions in the pool
+	Bytes uint64
+	// Transaction with the earliest time, nil if the pool is empty
+	Oldest types.ITransaction
+
+	// Limits of the pool
+	Capacity   int
+	MaxBytes   uint64
+	AccountTxs int
+	LifeTime   int64
+	Eviction   string
+
+	// Counters since the node started
+	Added           uint64
+	Replaced        uint64
+	Expired         uint64
+	EvictedFuture   uint64
+	Evicted         uint64
+	RejectedFull    uint64
+	RejectedAccount uint64
+}
+
+// Transaction rejected or evicted by the pool
+type RejectedTx struct {
+	Tx     types.ITransaction
+	Time   uint64
+	Reason string
+}
+
+// Transaction relayed by a peer
+type PeerTx struct {
+	Tx     types.ITransaction
+	PeerId string
+}
+
+// Result of a transaction applied to copies of the accounts
+type TxSimulation struct {
+	Tx types.ITransaction
+	// Fees paid to the block producer
+	Fees uint64
+	// Fees consumed by the transaction
+	Consumption uint64
+	// Accounts changed by the transaction, in the state after the
+	// transaction if it is valid
+	Accounts []types.IAccount
+	Err      error
+}
+
+// Transactions selected for the next block
+type BlockTemplate struct {
+	Height uint64
+	Txs    types.Transactions
+	// Total size of the transactions
+	Size        uint64
+	Fees        uint64
+	Consumption uint64
+	// Transactions that failed to apply, the following transactions
+	// of the same address are skipped as well
+	Failed []*RejectedTx
+	// The failed transactions that are invalid against the current state
+	// rather than conflicting with the block, they can be removed
+	Invalid []*RejectedTx
+}
@@ -1,9 +1,11 @@
 package types
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/crypto/ecc/secp256k1"
 	"github.com/uworldao/UWORLD/crypto/hash"
 	"github.com/uworldao/UWORLD/param"
 )
@@ -20,6 +22,14 @@ type Account struct {
 	Coins           *Coins
 	JournalIn       *journalIn
 	JournalOut      *journalOut
+
+	// Unlock schedules of the time-locked amounts received
+	TimeLocks TimeLockList `rlp:"optional"`
+	// Allowances granted to spenders, per spender and contract
+	Allowances AllowanceList `rlp:"optional"`
+	// Public key bound by key rotation, which controls the account
+	// instead of the key of the address
+	PubKey []byte `rlp:"optional"`
 }
 
 // Calculate user status key
@@ -58,12 +68,12 @@ func NewAccount() *Account {
 	}
 }
 
-func (a *Account) FallBack(height uint64) error {
+func (a *Account) FallBack(height, blockTime uint64) error {
 	if height < a.ConfirmedHeight {
 		return errors.New("too small fall back height")
 	}
 	if height > a.ConfirmedHeight {
-		if err := a.Update(height); err != nil {
+		if err := a.Update(height, blockTime); err != nil {
 			return err
 		}
 	}
@@ -84,13 +94,29 @@ func (a *Account) FallBack(height uint64) error {
 		(*a.Coins)[i] = coinAccount
 	}
 
+	// Time locks received in unconfirmed blocks are discarded
+	for _, lock := range a.TimeLocks {
+		if lock.Height > height {
+			coinAccount, ok := a.Coins.Get(lock.Contract)
+			if !ok || coinAccount.TimeLocked < lock.Amount {
+				return errors.New("wrong time lock")
+			}
+			coinAccount.TimeLocked -= lock.Amount
+			a.Coins.Set(coinAccount)
+		}
+	}
+	a.TimeLocks = a.TimeLocks.Remove(func(lock *TimeLock) bool {
+		return lock.Height > height
+	})
+
 	a.JournalIn = newJournalIn()
 	a.JournalOut = newJournalOut()
 	return nil
 }
 
-// Calculate the available balance of the current account based on the current effective block height
-func (a *Account) Update(confirmedHeight uint64) error {
+// Calculate the available balance of the current account based on the current
+// effective block height and the time of the effective block
+func (a *Account) Update(confirmedHeight, blockTime uint64) error {
 	confirmedNonce := a.ConfirmedNonce
 	confirmedTime := a.ConfirmedTime
 	// Update through the account transfer log information
@@ -113,7 +139,7 @@ func (a *Account) Update(confirmedHeight uint64) error {
 			} else {
 				return errors.New("locked in amount not enough when update account journal")
 			}
-			a.JournalIn.Remove(in.Height)
+			a.JournalIn.Remove(in.Height, in.Nonce)
 
 		} else {
 			return errors.New("locked in amount not enough when update account journal")
@@ -147,6 +173,25 @@ func (a *Account) Update(confirmedHeight uint64) error {
 			return errors.New("locked out amount not enough when update account Journal")
 		}
 	}
+
+	// Release the time-locked amounts whose unlock conditions are met
+	unlocked := func(lock *TimeLock) bool {
+		return lock.IsUnlocked(confirmedHeight, blockTime)
+	}
+	for _, lock := range a.TimeLocks {
+		if !unlocked(lock) {
+			continue
+		}
+		coinAccount, ok := a.Coins.Get(lock.Contract)
+		if !ok || coinAccount.TimeLocked < lock.Amount {
+			return errors.New("time locked amount not enough when update account")
+		}
+		coinAccount.TimeLocked -= lock.Amount
+		coinAccount.Balance += lock.Amount
+		a.Coins.Set(coinAccount)
+	}
+	a.TimeLocks = a.TimeLocks.Remove(unlocked)
+
 	a.ConfirmedHeight = confirmedHeight
 	a.ConfirmedNonce = confirmedNonce
 	a.ConfirmedTime = confirmedTime
@@ -165,21 +210,30 @@ func (a *Account) IsExist() bool {
 // the transfer-out and transfer-in are 0, no update is required.
 func (a *Account) IsNeedUpdate() bool {
 	for _, coinContract := range *a.Coins {
-		if coinContract.LockedIn != 0 || coinContract.LockedOut != 0 {
+		if coinContract.LockedIn != 0 || coinContract.LockedOut != 0 || coinContract.TimeLocked != 0 {
 			return true
 		}
 	}
 	return false
 }
 
-// Change the account status of the party that transferred the transaction
+// Change the account status of the party that transferred the transaction.
+// A sender may have several transactions in one block, they must be
+// applied in the order of nonce.
 func (a *Account) FromChange(tx ITransaction, blockHeight uint64) error {
-	if tx.GetTxType() == ContractTransaction {
-		return a.fromContractChange(tx, blockHeight)
-	}
 	if a.Nonce+1 != tx.GetNonce() {
 		return ErrNonce
 	}
+	switch tx.GetTxType() {
+	case ContractTransaction, HTLCClaimTransaction, HTLCRefundTransaction, TransferFromTransaction,
+		ContractPolicyTransaction, ContractMetadataTransaction, ContractIssuerTransaction,
+		NameRegisterTransaction, NameRenewTransaction, NameTransferTransaction, AnchorTransaction:
+		return a.fromContractChange(tx, blockHeight)
+	case ApproveTransaction:
+		return a.fromApproveChange(tx, blockHeight)
+	case KeyRotationTransaction:
+		return a.fromKeyRotationChange(tx, blockHeight)
+	}
 	contract := tx.GetTxBody().GetContract()
 	if contract == param.Token {
 		return a.fromTokenChange(tx, blockHeight)
@@ -191,7 +245,7 @@ func (a *Account) FromChange(tx ITransaction, blockHeight uint64) error {
 // Change the primary account status of one party to the transaction transfer
 func (a *Account) fromTokenChange(tx ITransaction, blockHeight uint64) error {
 	amount := tx.GetTxBody().GetAmount()
-	fees := tx.GetFees()
+	fees := senderFees(tx)
 	if !a.IsExist() {
 		a.Address = tx.From()
 	}
@@ -219,7 +273,7 @@ func (a *Account) fromTokenChange(tx ITransaction, blockHeight uint64) error {
 // The transaction of the secondary account needs to consume the fee of the
 // primary account.
 func (a *Account) fromCoinChange(tx ITransaction, blockHeight uint64) error {
-	fees := tx.GetFees()
+	fees := senderFees(tx)
 	txBody := tx.GetTxBody()
 	amount := txBody.GetAmount()
 	contract := txBody.GetContract()
@@ -251,9 +305,10 @@ func (a *Account) fromCoinChange(tx ITransaction, blockHeight uint64) error {
 	return nil
 }
 
-// Change of contract information
+// Change of contract information, or settlement of an HTLC, the
+// sender only pays the fees.
 func (a *Account) fromContractChange(tx ITransaction, blockHeight uint64) error {
-	fees := tx.GetFees()
+	fees := senderFees(tx)
 	tokenAccount, ok := a.Coins.Get(param.Token.String())
 	if !ok {
 		return errors.New("account is not exist")
@@ -270,6 +325,89 @@ func (a *Account) fromContractChange(tx ITransaction, blockHeight uint64) error
 	return nil
 }
 
+// Grant the allowance to the spender, the sender only pays the fees
+func (a *Account) fromApproveChange(tx ITransaction, blockHeight uint64) error {
+	txBody, ok := tx.GetTxBody().(*ApproveBody)
+	if !ok {
+		return ErrTxBody
+	}
+	if err := a.fromContractChange(tx, blockHeight); err != nil {
+		return err
+	}
+	a.Allowances = a.Allowances.Set(&Allowance{
+		Spender:  txBody.Spender.String(),
+		Contract: txBody.Contract.String(),
+		Amount:   txBody.Allowance,
+	})
+	return nil
+}
+
+// Bind the new key to the account, the sender only pays the fees
+func (a *Account) fromKeyRotationChange(tx ITransaction, blockHeight uint64) error {
+	txBody, ok := tx.GetTxBody().(*KeyRotationBody)
+	if !ok {
+		return ErrTxBody
+	}
+	if err := a.fromContractChange(tx, blockHeight); err != nil {
+		return err
+	}
+	a.PubKey = txBody.PubKey
+	return nil
+}
+
+// Verify that the public key controls the account. The key of the
+// address controls the account until another key is bound to it.
+func (a *Account) VerifySigner(signer hasharry.Address, pubKey []byte) bool {
+	if len(a.PubKey) == 0 {
+		return VerifySigner(param.Net, signer, pubKey)
+	}
+	key, err := secp256k1.ParsePubKey(pubKey)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(key.SerializeCompressed(), a.PubKey)
+}
+
+// The owner's amount of a transfer-from is deducted from the balance
+// and the allowance of the spender. The nonce of the owner is not
+// changed, so the amount is not recorded in the journal.
+func (a *Account) SpendAllowance(tx ITransaction) error {
+	txBody, ok := tx.GetTxBody().(*TransferFromBody)
+	if !ok {
+		return ErrTxBody
+	}
+	if err := a.VerifyAllowance(tx); err != nil {
+		return err
+	}
+	if !a.IsExist() {
+		a.Address = txBody.Owner
+	}
+	allowance, _ := a.Allowances.Get(tx.From().String(), txBody.Contract.String())
+	allowance.Amount -= txBody.Amount
+	a.Allowances = a.Allowances.Set(allowance)
+
+	coinAccount, _ := a.Coins.Get(txBody.Contract.String())
+	coinAccount.Balance -= txBody.Amount
+	a.Coins.Set(coinAccount)
+	return nil
+}
+
+// Verify that the allowance of the spender and the balance of
+// the owner are enough for the transfer-from.
+func (a *Account) VerifyAllowance(tx ITransaction) error {
+	txBody, ok := tx.GetTxBody().(*TransferFromBody)
+	if !ok {
+		return ErrTxBody
+	}
+	if a.GetAllowance(tx.From().String(), txBody.Contract.String()) < txBody.Amount {
+		return ErrAllowance
+	}
+	if a.GetBalance(txBody.Contract.String()) < txBody.Amount {
+		return ErrNotEnoughBalance
+	}
+	return nil
+}
+
 // Change of contract information
 func (a *Account) toContractChange(tx ITransaction, blockHeight uint64) error {
 	txBody := tx.GetTxBody()
@@ -302,24 +440,92 @@ func (a *Account) ToChange(tx ITransaction, blockHeight uint64) error {
 		return a.toContractChange(tx, blockHeight)
 	}
 
-	amount := txBody.GetAmount()
-	if txBody.GetContract().IsEqual(param.Token) {
-		amount = amount - tx.GetFees()
+	// The spender of a transfer-from pays the fees
+	if tx.GetTxType() == TransferFromTransaction {
+		a.ReceiveChange(txBody.ToAddress(), txBody.GetContract(), txBody.GetAmount(), blockHeight)
+		return nil
 	}
 
-	coinAccount, ok := a.Coins.Get(txBody.GetContract().String())
+	amount := netAmount(tx)
+	if tx.GetTxType() == TimeLockTransaction {
+		return a.toTimeLockChange(tx, amount, blockHeight)
+	}
+	a.ReceiveChange(txBody.ToAddress(), txBody.GetContract(), amount, blockHeight)
+	return nil
+}
+
+// Receive an amount that is not transferred by a transaction to this
+// account, such as the amount escrowed in an HTLC.
+func (a *Account) ReceiveChange(address, contract hasharry.Address, amount, blockHeight uint64) {
+	if !a.IsExist() {
+		a.Address = address
+	}
+	coinAccount, ok := a.Coins.Get(contract.String())
 	if ok {
 		coinAccount.LockedOut += amount
 	} else {
 		coinAccount = &CoinAccount{
-			Contract:  txBody.GetContract().String(),
+			Contract:  contract.String(),
 			Balance:   0,
 			LockedIn:  0,
 			LockedOut: amount,
 		}
 	}
 	a.Coins.Set(coinAccount)
-	a.JournalOut.Add(txBody.GetContract(), amount, blockHeight)
+	a.JournalOut.Add(contract, amount, blockHeight)
+}
+
+// The amount of a time-locked transfer is kept in the time-locked bucket
+// until the block is confirmed and the unlock conditions are met.
+func (a *Account) toTimeLockChange(tx ITransaction, amount, blockHeight uint64) error {
+	txBody, ok := tx.GetTxBody().(*TimeLockTransactionBody)
+	if !ok {
+		return ErrTxBody
+	}
+	coinAccount, ok := a.Coins.Get(txBody.Contract.String())
+	if ok {
+		coinAccount.TimeLocked += amount
+	} else {
+		coinAccount = &CoinAccount{
+			Contract:   txBody.Contract.String(),
+			TimeLocked: amount,
+		}
+	}
+	a.Coins.Set(coinAccount)
+	a.TimeLocks = append(a.TimeLocks, &TimeLock{
+		Contract:     txBody.Contract.String(),
+		Amount:       amount,
+		Height:       blockHeight,
+		UnlockHeight: txBody.UnlockHeight,
+		UnlockTime:   txBody.UnlockTime,
+	})
+	return nil
+}
+
+// The fees of a sponsored transaction are paid by the payer, the
+// payer's records are kept with nonce 0 which no transaction uses.
+func (a *Account) PayFees(tx ITransaction, blockHeight uint64) error {
+	if !a.IsExist() {
+		a.Address = tx.GetPayer()
+	}
+	fees := tx.GetFees()
+	tokenAccount, ok := a.Coins.Get(param.Token.String())
+	if !ok || tokenAccount.Balance < fees {
+		return ErrNotEnoughFees
+	}
+	tokenAccount.Balance -= fees
+	tokenAccount.LockedIn += fees
+	a.Coins.Set(tokenAccount)
+	a.JournalIn.AddFees(blockHeight, fees)
+	return nil
+}
+
+// Verify the balance of the payer of a sponsored transaction
+func (a *Account) VerifyPayFees(tx ITransaction) error {
+	tokenAccount, ok := a.Coins.Get(param.Token.String())
+	if !ok || tokenAccount.Balance < tx.GetFees() {
+		return ErrNotEnoughFees
+	}
 	return nil
 }
 
@@ -385,13 +591,13 @@ func (a *Account) VerifyTxState(tx ITransaction) error {
 
 	// Verify the balance of the token
 	switch tx.GetTxType() {
-	case NormalTransaction:
+	case NormalTransaction, TimeLockTransaction, HTLCLockTransaction, BurnTransaction:
 		if tx.GetTxBody().GetContract() == param.Token {
 			return a.verifyTokenTxBalance(tx)
 		} else {
 			return a.verifyCoinTxBalance(tx)
 		}
-	case ContractTransaction:
+	case ContractTransaction, TransferFromTransaction:
 		return a.verifyFees(tx)
 	default:
 		if tx.GetTxBody().GetAmount() != 0 {
@@ -437,12 +643,34 @@ func (a *Account) verifyFees(tx ITransaction) error {
 	tokenAccount, ok := a.Coins.Get(param.Token.String())
 	if !ok {
 		return ErrNotEnoughFees
-	} else if tokenAccount.Balance < tx.GetFees() {
+	} else if tokenAccount.Balance < senderFees(tx) {
 		return ErrNotEnoughFees
 	}
 	return nil
 }
 
+// The fees paid by the sender, which is 0 for a sponsored transaction
+func senderFees(tx ITransaction) uint64 {
+	if tx.IsSponsored() {
+		return 0
+	}
+	return tx.GetFees()
+}
+
+// The UWD burned by the transaction is kept by the burn address
+func (a *Account) BurnedChange(tx ITransaction, blockHeight uint64) {
+	a.ReceiveChange(param.BurnAddress, param.Token, netAmount(tx), blockHeight)
+}
+
+// The amount after the fees of the token transaction are deducted
+func netAmount(tx ITransaction) uint64 {
+	amount := tx.GetTxBody().GetAmount()
+	if tx.GetTxBody().GetContract().IsEqual(param.Token) {
+		amount -= senderFees(tx)
+	}
+	return amount
+}
+
 // The current nonce value of the block transaction must be the
 // nonce + 1 of the sender's account.
 func (a *Account) VerifyNonce(nonce uint64) error {
@@ -480,6 +708,22 @@ func (a *Account) GetLockedOut(contract string) uint64 {
 	return 0
 }
 
+func (a *Account) GetTimeLocked(contract string) uint64 {
+	coinAccount, ok := a.Coins.Get(contract)
+	if ok {
+		return coinAccount.TimeLocked
+	}
+	return 0
+}
+
+func (a *Account) GetAllowance(spender, contract string) uint64 {
+	allowance, ok := a.Allowances.Get(spender, contract)
+	if ok {
+		return allowance.Amount
+	}
+	return 0
+}
+
 func (a *Account) GetNonce() uint64 {
 	return a.Nonce
 }
@@ -511,8 +755,17 @@ func (a *Account) IsEmpty() bool {
 	if !a.JournalIn.IsEmpty() {
 		return false
 	}
+	if len(a.TimeLocks) != 0 {
+		return false
+	}
+	if len(a.Allowances) != 0 {
+		return false
+	}
+	if len(a.PubKey) != 0 {
+		return false
+	}
 	for _, coin := range *a.Coins {
-		if coin.Balance != 0 || coin.LockedIn != 0 || coin.LockedOut != 0 {
+		if coin.Balance != 0 || coin.LockedIn != 0 || coin.LockedOut != 0 || coin.TimeLocked != 0 {
 			return false
 		}
 	}
@@ -524,6 +777,9 @@ type CoinAccount struct {
 	Balance   uint64
 	LockedIn  uint64
 	LockedOut uint64
+
+	// Amount received by time-locked transfers that is not unlocked yet
+	TimeLocked uint64 `rlp:"optional"`
 }
 
 // List of secondary accounts
@@ -568,17 +824,30 @@ func (j *journalIn) Add(tx ITransaction, height uint64, contract hasharry.Addres
 	})
 }
 
-func (j *journalIn) Get(height uint64) *txIn {
-	in, ok := j.Ins.Get(height)
+// Record the fees paid for the transactions of other accounts
+func (j *journalIn) AddFees(height uint64, fees uint64) {
+	in, ok := j.Ins.Get(height, 0)
+	if !ok {
+		in = &txIn{
+			Contract: param.Token.String(),
+			Height:   height,
+		}
+	}
+	in.Fees += fees
+	j.Ins.Set(in)
+}
+
+func (j *journalIn) Get(height, nonce uint64) *txIn {
+	in, ok := j.Ins.Get(height, nonce)
 	if ok {
 		return in
 	}
 	return nil
 }
 
-func (j *journalIn) Remove(height uint64) uint64 {
-	tx, _ := j.Ins.Get(height)
-	j.Ins.Remove(height)
+func (j *journalIn) Remove(height, nonce uint64) uint64 {
+	tx, _ := j.Ins.Get(height, nonce)
+	j.Ins.Remove(height, nonce)
 	return tx.Amount
 }
 
@@ -630,11 +899,14 @@ type txIn struct {
 	Height   uint64
 }
 
+// Transfer-out records, an address can send more than one
+// transaction at the same height, so the records are
+// identified by height and nonce.
 type TxInList []*txIn
 
-func (t *TxInList) Get(height uint64) (*txIn, bool) {
+func (t *TxInList) Get(height, nonce uint64) (*txIn, bool) {
 	for _, txIn := range *t {
-		if txIn.Height == height {
+		if txIn.Height == height && txIn.Nonce == nonce {
 			return txIn, true
 		}
 	}
@@ -643,7 +915,7 @@ func (t *TxInList) Get(height uint64) (*txIn, bool) {
 
 func (t *TxInList) Set(txIn *txIn) {
 	for i, in := range *t {
-		if in.Height == txIn.Height {
+		if in.Height == txIn.Height && in.Nonce == txIn.Nonce {
 			(*t)[i] = txIn
 			return
 		}
@@ -651,9 +923,9 @@ func (t *TxInList) Set(txIn *txIn) {
 	*t = append(*t, txIn)
 }
 
-func (t *TxInList) Remove(height uint64) {
+func (t *TxInList) Remove(height, nonce uint64) {
 	for i, in := range *t {
-		if in.Height == height {
+		if in.Height == height && in.Nonce == nonce {
 			*t = append((*t)[0:i], (*t)[i+1:]...)
 			return
 		}
@@ -757,3 +1029,68 @@ func (o *OutList) Remove(height uint64, contract string) *OutAmount {
 	}
 	return nil
 }
+
+// The unlock schedule of a time-locked amount
+type TimeLock struct {
+	Contract string
+	Amount   uint64
+	// Height of the block that contains the transfer
+	Height       uint64
+	UnlockHeight uint64
+	UnlockTime   uint64
+}
+
+// The amount is unlocked when the block of the transfer is confirmed and
+// both the confirmed height and the time of the confirmed block reach the
+// unlock conditions.
+func (t *TimeLock) IsUnlocked(confirmedHeight, blockTime uint64) bool {
+	return t.Height <= confirmedHeight && t.UnlockHeight <= confirmedHeight && t.UnlockTime <= blockTime
+}
+
+type TimeLockList []*TimeLock
+
+// Remove the matched time locks, an empty list is returned as nil
+// so that accounts without time locks keep their encoding.
+func (t TimeLockList) Remove(match func(lock *TimeLock) bool) TimeLockList {
+	var list TimeLockList
+	for _, lock := range t {
+		if !match(lock) {
+			list = append(list, lock)
+		}
+	}
+	return list
+}
+
+// The amount of UWD or contract coins that the spender can
+// transfer from the account
+type Allowance struct {
+	Spender  string
+	Contract string
+	Amount   uint64
+}
+
+type AllowanceList []*Allowance
+
+func (l AllowanceList) Get(spender, contract string) (*Allowance, bool) {
+	for _, allowance := range l {
+		if allowance.Spender == spender && allowance.Contract == contract {
+			return allowance, true
+		}
+	}
+	return &Allowance{Spender: spender, Contract: contract}, false
+}
+
+// Set the allowance, an allowance of 0 is removed and an empty
+// list is returned as nil so that the account keeps its encoding.
+func (l AllowanceList) Set(newAllowance *Allowance) AllowanceList {
+	var list AllowanceList
+	for _, allowance := range l {
+		if allowance.Spender != newAllowance.Spender || allowance.Contract != newAllowance.Contract {
+			list = append(list, allowance)
+		}
+	}
+	if newAllowance.Amount != 0 {
+		list = append(list, newAllowance)
+	}
+	return list
+}
@@ -0,0 +1,144 @@
+package types
+
+import (
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/param"
+	"testing"
+)
+
+func newTestTx(from, to hasharry.Address, nonce, amount uint64) *Transaction {
+	return &Transaction{
+		TxHead: &TransactionHead{
+			TxType: NormalTransaction,
+			From:   from,
+			Nonce:  nonce,
+			Fees:   param.Fees,
+			Time:   nonce,
+		},
+		TxBody: &NormalTransactionBody{
+			Contract: param.Token,
+			To:       to,
+			Amount:   amount,
+		},
+	}
+}
+
+func TestAccount_FromChangeSameHeight(t *testing.T) {
+	from := hasharry.StringToAddress("UWDM1qcsk7UUNANMPKSpALJW7AqpDCy7tdoN")
+	to := hasharry.StringToAddress("UWDNQhgkNHCLdVhCFvpo6bGXXdcKtTTfeQZE")
+	account := NewAccount()
+	account.Address = from
+	tokenAccount, _ := account.Coins.Get(param.Token.String())
+	tokenAccount.Balance = 10 * param.AtomsPerCoin
+
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		if err := account.FromChange(newTestTx(from, to, nonce, param.AtomsPerCoin), 5); err != nil {
+			t.Fatalf("nonce %d: %v", nonce, err)
+		}
+	}
+	if err := account.FromChange(newTestTx(from, to, 5, param.AtomsPerCoin), 5); err != ErrNonce {
+		t.Fatalf("expected ErrNonce, got %v", err)
+	}
+	if len(*account.JournalIn.Ins) != 3 {
+		t.Fatalf("expected 3 journal records, got %d", len(*account.JournalIn.Ins))
+	}
+
+	if err := account.Update(5, 0); err != nil {
+		t.Fatal(err)
+	}
+	if !account.JournalIn.IsEmpty() {
+		t.Fatal("journal should be empty after confirmation")
+	}
+	if account.GetLockedIn(param.Token.String()) != 0 {
+		t.Fatalf("locked in should be 0, got %d", account.GetLockedIn(param.Token.String()))
+	}
+	if account.GetBalance(param.Token.String()) != 7*param.AtomsPerCoin {
+		t.Fatalf("wrong balance %d", account.GetBalance(param.Token.String()))
+	}
+	if account.GetConfirmedNonce() != 3 {
+		t.Fatalf("wrong confirmed nonce %d", account.GetConfirmedNonce())
+	}
+}
+
+func TestAccount_TimeLock(t *testing.T) {
+	from := hasharry.StringToAddress("UWDM1qcsk7UUNANMPKSpALJW7AqpDCy7tdoN")
+	to := hasharry.StringToAddress("UWDNQhgkNHCLdVhCFvpo6bGXXdcKtTTfeQZE")
+	tx := newTestTx(from, to, 1, 2*param.AtomsPerCoin)
+	tx.TxHead.TxType = TimeLockTransaction
+	tx.TxBody = &TimeLockTransactionBody{
+		Contract:     param.Token,
+		To:           to,
+		Amount:       2 * param.AtomsPerCoin,
+		UnlockHeight: 10,
+		UnlockTime:   1000,
+	}
+	account := NewAccount()
+	if err := account.ToChange(tx, 5); err != nil {
+		t.Fatal(err)
+	}
+	locked := 2*param.AtomsPerCoin - param.Fees
+	if account.GetTimeLocked(param.Token.String()) != locked {
+		t.Fatalf("wrong time locked amount %d", account.GetTimeLocked(param.Token.String()))
+	}
+
+	// Both the unlock height and the unlock time must be reached
+	for _, confirmed := range []struct{ height, time uint64 }{{5, 2000}, {10, 999}} {
+		if err := account.Update(confirmed.height, confirmed.time); err != nil {
+			t.Fatal(err)
+		}
+		if account.GetBalance(param.Token.String()) != 0 || len(account.TimeLocks) != 1 {
+			t.Fatalf("unlocked too early at height %d time %d", confirmed.height, confirmed.time)
+		}
+	}
+	if err := account.Update(10, 1000); err != nil {
+		t.Fatal(err)
+	}
+	if account.GetBalance(param.Token.String()) != locked || account.GetTimeLocked(param.Token.String()) != 0 {
+		t.Fatalf("wrong balance %d after unlock", account.GetBalance(param.Token.String()))
+	}
+	if account.TimeLocks != nil {
+		t.Fatal("time locks should be empty after unlock")
+	}
+}
+
+func TestAccount_Allowance(t *testing.T) {
+	owner := hasharry.StringToAddress("UWDM1qcsk7UUNANMPKSpALJW7AqpDCy7tdoN")
+	spender := hasharry.StringToAddress("UWDNQhgkNHCLdVhCFvpo6bGXXdcKtTTfeQZE")
+	account := NewAccount()
+	account.Address = owner
+	tokenAccount, _ := account.Coins.Get(param.Token.String())
+	tokenAccount.Balance = 10 * param.AtomsPerCoin
+
+	approve := newTestTx(owner, spender, 1, 0)
+	approve.TxHead.TxType = ApproveTransaction
+	approve.TxBody = &ApproveBody{Contract: param.Token, Spender: spender, Allowance: 3 * param.AtomsPerCoin}
+	if err := account.FromChange(approve, 1); err != nil {
+		t.Fatal(err)
+	}
+	if account.GetAllowance(spender.String(), param.Token.String()) != 3*param.AtomsPerCoin {
+		t.Fatal("wrong allowance")
+	}
+
+	transferFrom := func(amount uint64) *Transaction {
+		tx := newTestTx(spender, spender, 1, 0)
+		tx.TxHead.TxType = TransferFromTransaction
+		tx.TxBody = &TransferFromBody{Contract: param.Token, Owner: owner, To: spender, Amount: amount}
+		return tx
+	}
+	if err := account.SpendAllowance(transferFrom(2 * param.AtomsPerCoin)); err != nil {
+		t.Fatal(err)
+	}
+	if err := account.SpendAllowance(transferFrom(2 * param.AtomsPerCoin)); err != ErrAllowance {
+		t.Fatalf("expected ErrAllowance, got %v", err)
+	}
+	balance := 10*param.AtomsPerCoin - param.Fees - 2*param.AtomsPerCoin
+	if account.GetBalance(param.Token.String()) != balance {
+		t.Fatalf("wrong balance %d", account.GetBalance(param.Token.String()))
+	}
+	if err := account.SpendAllowance(transferFrom(param.AtomsPerCoin)); err != nil {
+		t.Fatal(err)
+	}
+	if account.Allowances != nil {
+		t.Fatal("a used up allowance should be removed")
+	}
+}
@@ -0,0 +1,119 @@
+package types
+
+import (
+	"errors"
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/param"
+	"github.com/uworldao/UWORLD/ut"
+)
+
+// Grant the spender an allowance of UWD or contract coins held by the
+// sender, the allowance replaces the previous one and 0 revokes it.
+type ApproveBody struct {
+	Contract  hasharry.Address
+	Spender   hasharry.Address
+	Allowance uint64
+}
+
+func (ab *ApproveBody) ToAddress() hasharry.Address {
+	return ab.Spender
+}
+
+// Approve does not transfer any amount
+func (ab *ApproveBody) GetAmount() uint64 {
+	return 0
+}
+
+func (ab *ApproveBody) GetContract() hasharry.Address {
+	return ab.Contract
+}
+
+func (ab *ApproveBody) GetName() string {
+	return ""
+}
+
+func (ab *ApproveBody) GetAbbr() string {
+	return ""
+}
+
+func (ab *ApproveBody) GetIncreaseSwitch() bool {
+	return false
+}
+
+func (ab *ApproveBody) GetDescription() string {
+	return ""
+}
+
+func (ab *ApproveBody) GetPeerId() []byte {
+	return nil
+}
+
+func (ab *ApproveBody) VerifyBody(from hasharry.Address) error {
+	if !ut.IsValidContractAddress(param.Net, ab.Contract.String()) {
+		return ErrContractAddr
+	}
+	if !ut.CheckUWDAddress(param.Net, ab.Spender.String()) {
+		return ErrAddress
+	}
+	if ab.Spender.IsEqual(from) {
+		return errors.New("cannot approve to yourself")
+	}
+	return nil
+}
+
+// Transfer the amount of the owner to the receiver within the allowance
+// granted to the sender, the sender pays the fees.
+type TransferFromBody struct {
+	Contract hasharry.Address
+	Owner    hasharry.Address
+	To       hasharry.Address
+	Amount   uint64
+}
+
+func (tb *TransferFromBody) ToAddress() hasharry.Address {
+	return tb.To
+}
+
+func (tb *TransferFromBody) GetAmount() uint64 {
+	return tb.Amount
+}
+
+func (tb *TransferFromBody) GetContract() hasharry.Address {
+	return tb.Contract
+}
+
+func (tb *TransferFromBody) GetName() string {
+	return ""
+}
+
+func (tb *TransferFromBody) GetAbbr() string {
+	return ""
+}
+
+func (tb *TransferFromBody) GetIncreaseSwitch() bool {
+	return false
+}
+
+func (tb *TransferFromBody) GetDescription() string {
+	return ""
+}
+
+func (tb *TransferFromBody) GetPeerId() []byte {
+	return nil
+}
+
+func (tb *TransferFromBody) VerifyBody(from hasharry.Address) error {
+	if !ut.IsValidContractAddress(param.Net, tb.Contract.String()) {
+		return ErrContractAddr
+	}
+	if !ut.CheckUWDAddress(param.Net, tb.Owner.String()) {
+		return ErrAddress
+	}
+	if !ut.CheckUWDAddress(param.Net, tb.To.String()) {
+		return ErrAddress
+	}
+	if tb.Owner.IsEqual(from) {
+		return errors.New("the owner should transfer directly")
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/common/hexutil"
+	"github.com/uworldao/UWORLD/crypto/hash"
+)
+
+// A digest anchored in a block, with the proof that the
+// anchor transaction is included in the block
+type Anchor struct {
+	Digest hasharry.Hash
+	TxHash hasharry.Hash
+	Header *Header
+	Proof  *TxProof
+}
+
+// Proof that a transaction is included in the transaction root
+// of a block. The transaction root is the hash of the joined
+// hashes of all transactions in the block.
+type TxProof struct {
+	TxIndex  uint32
+	TxHashes []hasharry.Hash
+}
+
+func NewTxProof(txs Transactions, index uint32) *TxProof {
+	proof := &TxProof{TxIndex: index}
+	for _, tx := range txs {
+		proof.TxHashes = append(proof.TxHashes, tx.Hash())
+	}
+	return proof
+}
+
+// Verify that the transaction is at the index of the proof
+// and the hashes of the proof match the transaction root
+func (p *TxProof) Verify(txHash, txRoot hasharry.Hash) bool {
+	if int(p.TxIndex) >= len(p.TxHashes) || !p.TxHashes[p.TxIndex].IsEqual(txHash) {
+		return false
+	}
+	var hashes [][]byte
+	for _, txHash := range p.TxHashes {
+		hashes = append(hashes, txHash.Bytes())
+	}
+	return hash.Hash(bytes.Join(hashes, []byte{})).IsEqual(txRoot)
+}
+
+// Parse a digest of exactly 32 bytes in hex
+func ParseDigest(s string) (hasharry.Hash, error) {
+	digest, err := hexutil.Decode(s)
+	if err != nil || len(digest) != hasharry.HashLength {
+		return hasharry.Hash{}, errors.New("the digest must be 32 bytes in hex")
+	}
+	return hasharry.BytesToHash(digest), nil
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/crypto/hash"
+	"testing"
+)
+
+func TestTxProof_Verify(t *testing.T) {
+	from := hasharry.StringToAddress("UWDM1qcsk7UUNANMPKSpALJW7AqpDCy7tdoN")
+	digest := hash.Hash([]byte("document"))
+	anchor := newTestTx(from, hasharry.Address{}, 2, 0)
+	anchor.TxHead.TxType = AnchorTransaction
+	anchor.TxBody = &AnchorBody{Digests: []hasharry.Hash{digest}}
+	anchor.SetHash()
+	normal := newTestTx(from, from, 1, 1)
+	normal.SetHash()
+	block := &Block{Header: &Header{}, Body: &Body{Transactions: Transactions{normal, anchor}}}
+	block.TxRoot = block.Transactions.Hash()
+
+	anchors := block.GetAnchors()
+	if !anchors[digest].IsEqual(anchor.Hash()) {
+		t.Fatal("the digest is not indexed")
+	}
+	proof := NewTxProof(block.Transactions, 1)
+	if !proof.Verify(anchor.Hash(), block.TxRoot) {
+		t.Fatal("wrong proof")
+	}
+	if proof.Verify(normal.Hash(), block.TxRoot) {
+		t.Fatal("the transaction is not at the index")
+	}
+	proof.TxHashes = proof.TxHashes[1:]
+	proof.TxIndex = 0
+	if proof.Verify(anchor.Hash(), block.TxRoot) {
+		t.Fatal("the proof does not match the tx root")
+	}
+}
+
+func TestAnchorBody_VerifyBody(t *testing.T) {
+	digest := hash.Hash([]byte("document"))
+	if err := (&AnchorBody{Digests: []hasharry.Hash{digest}}).VerifyBody(hasharry.Address{}); err != nil {
+		t.Fatal(err)
+	}
+	for _, digests := range [][]hasharry.Hash{nil, {digest, digest}, {{}}, make([]hasharry.Hash, MaxAnchorDigests+1)} {
+		if err := (&AnchorBody{Digests: digests}).VerifyBody(hasharry.Address{}); err == nil {
+			t.Fatalf("invalid digests %v", digests)
+		}
+	}
+	if _, err := ParseDigest("0x1234"); err == nil {
+		t.Fatal("the digest must be 32 bytes")
+	}
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"github.com/uworldao/UWORLD/common/hasharry"
+)
+
+// Maximum number of digests anchored by a transaction
+const MaxAnchorDigests = 32
+
+// Anchor the 32-byte digests of documents on the chain,
+// nothing is transferred
+type AnchorBody struct {
+	Digests []hasharry.Hash
+}
+
+func (ab *AnchorBody) ToAddress() hasharry.Address {
+	return hasharry.Address{}
+}
+
+func (ab *AnchorBody) GetAmount() uint64 {
+	return 0
+}
+
+func (ab *AnchorBody) GetContract() hasharry.Address {
+	return hasharry.Address{}
+}
+
+func (ab *AnchorBody) GetName() string {
+	return ""
+}
+
+func (ab *AnchorBody) GetAbbr() string {
+	return ""
+}
+
+func (ab *AnchorBody) GetIncreaseSwitch() bool {
+	return false
+}
+
+func (ab *AnchorBody) GetDescription() string {
+	return ""
+}
+
+func (ab *AnchorBody) GetPeerId() []byte {
+	return nil
+}
+
+func (ab *AnchorBody) VerifyBody(from hasharry.Address) error {
+	if len(ab.Digests) == 0 || len(ab.Digests) > MaxAnchorDigests {
+		return fmt.Errorf("the number of digests must be in the range of 1 and %d", MaxAnchorDigests)
+	}
+	digests := make(map[hasharry.Hash]bool)
+	for _, digest := range ab.Digests {
+		if digest.IsEqual(hasharry.Hash{}) {
+			return errors.New("empty digest")
+		}
+		if digests[digest] {
+			return fmt.Errorf("repeated digest %s", digest.String())
+		}
+		digests[digest] = true
+	}
+	return nil
+}
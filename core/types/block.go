@@ -42,3 +42,45 @@ func (b *Block) GetTxsLocations() map[hash2.Hash]*TxLocation {
 	}
 	return mapLocation
 }
+
+// Get the anchor transaction of each digest anchored in the block,
+// the first anchor of a digest in the block is kept
+func (b *Block) GetAnchors() map[hash2.Hash]hash2.Hash {
+	anchors := make(map[hash2.Hash]hash2.Hash)
+	for _, tx := range b.Transactions {
+		body, ok := tx.GetTxBody().(*AnchorBody)
+		if !ok {
+			continue
+		}
+		for _, digest := range body.Digests {
+			if _, ok := anchors[digest]; !ok {
+				anchors[digest] = tx.Hash()
+			}
+		}
+	}
+	return anchors
+}
+
+// Get the public keys of the signers of the transactions in the block,
+// a key bound by a key rotation takes precedence over the signatures
+func (b *Block) GetPubKeys() map[hash2.Address][]byte {
+	pubKeys := make(map[hash2.Address][]byte)
+	rotated := make(map[hash2.Address]bool)
+	for _, tx := range b.Transactions {
+		if tx.IsCoinBase() {
+			continue
+		}
+		if txBody, ok := tx.GetTxBody().(*KeyRotationBody); ok {
+			pubKeys[tx.From()] = txBody.PubKey
+			rotated[tx.From()] = true
+			continue
+		}
+		if signScript := tx.GetSignScript(); signScript != nil && len(signScript.PubKey) != 0 && !rotated[tx.From()] {
+			pubKeys[tx.From()] = signScript.PubKey
+		}
+		if payerTx, ok := tx.(*Transaction); ok && payerTx.IsSponsored() && payerTx.TxHead.PayerSignScript != nil && !rotated[payerTx.GetPayer()] {
+			pubKeys[payerTx.GetPayer()] = payerTx.TxHead.PayerSignScript.PubKey
+		}
+	}
+	return pubKeys
+}
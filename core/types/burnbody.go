@@ -0,0 +1,52 @@
+package types
+
+import (
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/param"
+	"github.com/uworldao/UWORLD/ut"
+)
+
+// Destroy the amount of UWD or contract coins held by the sender
+type BurnBody struct {
+	Contract hasharry.Address
+	Amount   uint64
+}
+
+func (bb *BurnBody) ToAddress() hasharry.Address {
+	return hasharry.Address{}
+}
+
+func (bb *BurnBody) GetAmount() uint64 {
+	return bb.Amount
+}
+
+func (bb *BurnBody) GetContract() hasharry.Address {
+	return bb.Contract
+}
+
+func (bb *BurnBody) GetName() string {
+	return ""
+}
+
+func (bb *BurnBody) GetAbbr() string {
+	return ""
+}
+
+func (bb *BurnBody) GetIncreaseSwitch() bool {
+	return false
+}
+
+func (bb *BurnBody) GetDescription() string {
+	return ""
+}
+
+func (bb *BurnBody) GetPeerId() []byte {
+	return nil
+}
+
+func (bb *BurnBody) VerifyBody(from hasharry.Address) error {
+	if !ut.IsValidContractAddress(param.Net, bb.Contract.String()) {
+		return ErrContractAddr
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/uworldao/UWORLD/common/hasharry"
 	"github.com/uworldao/UWORLD/param"
+	"github.com/uworldao/UWORLD/ut"
 )
 
 // Contract structure, issuing a contract with the same
@@ -15,7 +16,20 @@ type Contract struct {
 	CoinAbbr       string
 	Description    string
 	IncreaseSwitch bool
-	Records        *RecordList
+	// Address that published the contract or received the issuer
+	// rights, only the issuer can issue additional coins. It is empty
+	// for the contracts published before the issuer was recorded.
+	Issuer      string
+	MetadataURI string
+	Records     *RecordList
+	// Total amount burned by the holders
+	Burned uint64
+
+	// Policies selected by the issuer and their state
+	Policy    uint8
+	Paused    bool
+	Frozen    []string
+	Allowlist []string
 }
 
 func NewContract() *Contract {
@@ -36,6 +50,9 @@ func (c *Contract) Verify(tx ITransaction) error {
 	if c.Contract != "" && !c.IncreaseSwitch {
 		return errors.New("this contract does not support additional issuance")
 	}
+	if !c.IsIssuer(tx.From()) {
+		return errors.New("only the issuer can issue additional coins")
+	}
 	if c.CoinName != txBody.GetName() {
 		return errors.New("coin name is not consistent")
 	}
@@ -70,6 +87,73 @@ func (c *Contract) FallBack(height uint64) error {
 	return nil
 }
 
+// Whether the address is the issuer of the contract. The contracts
+// published before the issuer was recorded belong to the address
+// that the contract address is derived from.
+func (c *Contract) IsIssuer(address hasharry.Address) bool {
+	if c.Issuer == "" {
+		return ut.CheckContractAddress(param.Net, address.String(), c.CoinAbbr, c.Contract)
+	}
+	return c.Issuer == address.String()
+}
+
+// Whether the transaction can only be sent by the issuer of the contract
+func IsIssuerTransaction(tx ITransaction) bool {
+	switch tx.GetTxType() {
+	case ContractTransaction, ContractPolicyTransaction, ContractMetadataTransaction, ContractIssuerTransaction:
+		return true
+	}
+	return false
+}
+
+// Verify the metadata update or the issuer transfer,
+// only the issuer can change the contract.
+func (c *Contract) VerifyUpdate(tx ITransaction) error {
+	if !c.IsIssuer(tx.From()) {
+		return errors.New("only the issuer can change the contract")
+	}
+	if c.IsExist(tx.Hash()) {
+		return errors.New("duplicate transaction hash")
+	}
+	return nil
+}
+
+// Apply the metadata update or the issuer transfer and
+// keep the change in the records.
+func (c *Contract) Update(tx ITransaction, blockHeight uint64) error {
+	record := &ContractRecord{
+		Height: blockHeight,
+		TxHash: tx.Hash(),
+		Time:   tx.GetTime(),
+	}
+	switch body := tx.GetTxBody().(type) {
+	case *ContractMetadataBody:
+		c.Description = body.Description
+		c.MetadataURI = body.MetadataURI
+		record.Action = RecordMetadata
+		record.Description = body.Description
+		record.MetadataURI = body.MetadataURI
+	case *ContractIssuerBody:
+		c.Issuer = body.Issuer.String()
+		record.Action = RecordIssuer
+		record.Receiver = body.Issuer.String()
+	default:
+		return ErrTxBody
+	}
+	c.AddContract(record)
+	return nil
+}
+
+// Burn the amount of the burn transaction
+func (c *Contract) Burn(tx ITransaction) {
+	c.Burned += netAmount(tx)
+}
+
+// Total amount issued by the contract
+func (c *Contract) Issued() uint64 {
+	return c.amount()
+}
+
 func (c *Contract) amount() uint64 {
 	var sum uint64
 	for _, record := range *c.Records {
@@ -78,12 +162,32 @@ func (c *Contract) amount() uint64 {
 	return sum
 }
 
+// Actions of the contract records, the records of issuance
+// published before the actions were recorded have no action.
+const (
+	RecordIssue    = "issue"
+	RecordMetadata = "metadata"
+	RecordIssuer   = "issuer"
+)
+
 type ContractRecord struct {
 	Height   uint64
 	TxHash   hasharry.Hash
 	Time     uint64
 	Amount   uint64
 	Receiver string
+
+	Action      string
+	Description string
+	MetadataURI string
+}
+
+// The action of the record
+func (c *ContractRecord) GetAction() string {
+	if c.Action == "" {
+		return RecordIssue
+	}
+	return c.Action
 }
 
 type RecordList []*ContractRecord
@@ -99,7 +203,7 @@ func (r *RecordList) Get(height uint64) (*ContractRecord, bool) {
 
 func (r *RecordList) Set(newRecord *ContractRecord) {
 	for i, record := range *r {
-		if newRecord.Height == record.Height {
+		if newRecord.TxHash.IsEqual(record.TxHash) {
 			(*r)[i] = newRecord
 			return
 		}
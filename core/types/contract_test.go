@@ -0,0 +1,149 @@
+package types
+
+import (
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/param"
+	"testing"
+)
+
+func TestContract_Verify(t *testing.T) {
+	issuer := hasharry.StringToAddress("UWDM1qcsk7UUNANMPKSpALJW7AqpDCy7tdoN")
+	other := hasharry.StringToAddress("UWDNQhgkNHCLdVhCFvpo6bGXXdcKtTTfeQZE")
+	contractAddr := hasharry.StringToAddress("UWTKoLj4mRTKr4SjyyFG4LY3ExZVSZT9dNZv")
+	mint := func(from hasharry.Address, nonce, amount uint64) *Transaction {
+		tx := newTestTx(from, from, nonce, 0)
+		tx.TxHead.TxType = ContractTransaction
+		tx.TxBody = &ContractBody{
+			Contract:       contractAddr,
+			To:             from,
+			Name:           "Test Coin",
+			Abbr:           "TC",
+			Amount:         amount,
+			IncreaseSwitch: true,
+		}
+		tx.SetHash()
+		return tx
+	}
+	contract := &Contract{
+		Contract:       contractAddr.String(),
+		CoinName:       "Test Coin",
+		CoinAbbr:       "TC",
+		IncreaseSwitch: true,
+		Issuer:         issuer.String(),
+		Records:        &RecordList{},
+	}
+	for nonce := uint64(1); nonce <= 10; nonce++ {
+		tx := mint(issuer, nonce, param.MaxContractCoin)
+		if err := contract.Verify(tx); err != nil {
+			t.Fatal(err)
+		}
+		contract.AddContract(&ContractRecord{Height: 10, TxHash: tx.Hash(), Amount: param.MaxContractCoin})
+	}
+	if contract.Issued() != param.MaxAllContractCoin {
+		t.Fatalf("issuances in the same block are lost, issued %d", contract.Issued())
+	}
+	if err := contract.Verify(mint(issuer, 11, 1)); err == nil {
+		t.Fatal("the total limit is exceeded")
+	}
+
+	contract.Records = &RecordList{}
+	if err := contract.Verify(mint(other, 1, 1)); err == nil {
+		t.Fatal("only the issuer can issue additional coins")
+	}
+	contract.IncreaseSwitch = false
+	if err := contract.Verify(mint(issuer, 1, 1)); err == nil {
+		t.Fatal("the contract does not support additional issuance")
+	}
+}
+
+func TestContract_Policy(t *testing.T) {
+	issuer := hasharry.StringToAddress("UWDM1qcsk7UUNANMPKSpALJW7AqpDCy7tdoN")
+	holder := hasharry.StringToAddress("UWDNQhgkNHCLdVhCFvpo6bGXXdcKtTTfeQZE")
+	contractAddr := hasharry.StringToAddress("UWTKoLj4mRTKr4SjyyFG4LY3ExZVSZT9dNZv")
+	policyTx := func(from hasharry.Address, action PolicyAction, holder hasharry.Address) *Transaction {
+		tx := newTestTx(from, holder, 1, 0)
+		tx.TxHead.TxType = ContractPolicyTransaction
+		tx.TxBody = &ContractPolicyBody{Contract: contractAddr, Action: action, Holder: holder}
+		return tx
+	}
+	contract := &Contract{
+		Contract: contractAddr.String(),
+		Issuer:   issuer.String(),
+		Policy:   PolicyFreeze | PolicyAllowlist,
+		Records:  &RecordList{},
+	}
+	apply := func(tx *Transaction) {
+		if err := contract.VerifyPolicy(tx); err != nil {
+			t.Fatal(err)
+		}
+		contract.UpdatePolicy(tx)
+	}
+	if err := contract.VerifyTransfer(issuer, holder); err == nil {
+		t.Fatal("the holder is not in the allowlist")
+	}
+	apply(policyTx(issuer, AllowHolder, holder))
+	if err := contract.VerifyTransfer(holder, issuer); err != nil {
+		t.Fatal(err)
+	}
+	apply(policyTx(issuer, FreezeHolder, holder))
+	if err := contract.VerifyTransfer(holder, issuer); err == nil {
+		t.Fatal("the holder is frozen")
+	}
+	if err := contract.VerifyPolicy(policyTx(holder, UnfreezeHolder, holder)); err == nil {
+		t.Fatal("only the issuer can change the policy")
+	}
+	if err := contract.VerifyPolicy(policyTx(issuer, PauseContract, hasharry.Address{})); err == nil {
+		t.Fatal("the pause policy is not selected")
+	}
+	apply(policyTx(issuer, UnfreezeHolder, holder))
+	if err := contract.VerifyTransfer(holder, hasharry.Address{}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestContract_Update(t *testing.T) {
+	issuer := hasharry.StringToAddress("UWDM1qcsk7UUNANMPKSpALJW7AqpDCy7tdoN")
+	newIssuer := hasharry.StringToAddress("UWDNQhgkNHCLdVhCFvpo6bGXXdcKtTTfeQZE")
+	contractAddr := hasharry.StringToAddress("UWTKoLj4mRTKr4SjyyFG4LY3ExZVSZT9dNZv")
+	contract := &Contract{
+		Contract: contractAddr.String(),
+		Issuer:   issuer.String(),
+		Records:  &RecordList{{Height: 1, Amount: 100}},
+	}
+	update := func(from hasharry.Address, nonce uint64, body ITransactionBody, txType TransactionType) *Transaction {
+		tx := newTestTx(from, hasharry.Address{}, nonce, 0)
+		tx.TxHead.TxType = txType
+		tx.TxBody = body
+		tx.SetHash()
+		return tx
+	}
+	metadata := update(issuer, 1, &ContractMetadataBody{Contract: contractAddr, Description: "new", MetadataURI: "https://example.com/tc.json"}, ContractMetadataTransaction)
+	if err := contract.VerifyUpdate(metadata); err != nil {
+		t.Fatal(err)
+	}
+	contract.Update(metadata, 5)
+	transfer := update(issuer, 2, &ContractIssuerBody{Contract: contractAddr, Issuer: newIssuer}, ContractIssuerTransaction)
+	if err := contract.VerifyUpdate(transfer); err != nil {
+		t.Fatal(err)
+	}
+	contract.Update(transfer, 5)
+	if contract.Issuer != newIssuer.String() || contract.Description != "new" || contract.MetadataURI != "https://example.com/tc.json" {
+		t.Fatal("the contract is not updated")
+	}
+	if err := contract.VerifyUpdate(update(issuer, 3, &ContractMetadataBody{Contract: contractAddr}, ContractMetadataTransaction)); err == nil {
+		t.Fatal("the old issuer cannot change the contract")
+	}
+
+	actions := []string{RecordIssue, RecordMetadata, RecordIssuer}
+	if contract.Records.Len() != len(actions) {
+		t.Fatalf("wrong records %d", contract.Records.Len())
+	}
+	for i, record := range *contract.Records {
+		if record.GetAction() != actions[i] {
+			t.Fatalf("wrong action %s of record %d", record.GetAction(), i)
+		}
+	}
+	if contract.Issued() != 100 {
+		t.Fatalf("wrong issued %d", contract.Issued())
+	}
+}
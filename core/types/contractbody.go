@@ -21,6 +21,9 @@ type ContractBody struct {
 	Description    string
 	Amount         uint64
 	IncreaseSwitch bool
+
+	// Policies that the issuer opts into
+	Policy uint8 `rlp:"optional"`
 }
 
 func (c *ContractBody) ToAddress() hasharry.Address {
@@ -59,7 +62,7 @@ func (c *ContractBody) VerifyBody(from hasharry.Address) error {
 	if err := c.verifyAttribute(); err != nil {
 		return err
 	}
-	if err := c.verifyContractAddress(from); err != nil {
+	if err := c.verifyContractAddress(); err != nil {
 		return err
 	}
 	if err := c.verifyContractTo(from); err != nil {
@@ -68,8 +71,8 @@ func (c *ContractBody) VerifyBody(from hasharry.Address) error {
 	if err := c.verifyAmount(); err != nil {
 		return err
 	}
-	if err := c.verifyIncreaseSwitch(); err != nil {
-		return err
+	if c.Policy&^AllPolicies != 0 {
+		return errors.New("unknown policy")
 	}
 	return nil
 }
@@ -87,8 +90,10 @@ func (c *ContractBody) verifyAttribute() error {
 	return nil
 }
 
-func (c *ContractBody) verifyContractAddress(from hasharry.Address) error {
-	if !ut.CheckContractAddress(param.Net, from.String(), c.Abbr, c.Contract.String()) {
+// Whether the contract address is derived from the sender depends on
+// whether the contract exists, which is verified by the contract state.
+func (c *ContractBody) verifyContractAddress() error {
+	if c.Contract.IsEqual(param.Token) || !ut.IsValidContractAddress(param.Net, c.Contract.String()) {
 		return errors.New("check contract address failed")
 	}
 	return nil
@@ -107,10 +112,3 @@ func (c *ContractBody) verifyAmount() error {
 	}
 	return nil
 }
-
-func (c *ContractBody) verifyIncreaseSwitch() error {
-	if c.IncreaseSwitch {
-		return fmt.Errorf("additional issuance is not allowed")
-	}
-	return nil
-}
@@ -0,0 +1,207 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"github.com/uworldao/UWORLD/common/hasharry"
+)
+
+// Policies that the issuer opts into when publishing a contract,
+// the issuer can only use the controls of the selected policies.
+const (
+	PolicyFreeze uint8 = 1 << iota
+	PolicyPause
+	PolicyAllowlist
+
+	AllPolicies = PolicyFreeze | PolicyPause | PolicyAllowlist
+)
+
+var policyNames = map[uint8]string{
+	PolicyFreeze:    "freeze",
+	PolicyPause:     "pause",
+	PolicyAllowlist: "allowlist",
+}
+
+// Parse the policy name to the policy
+func ParsePolicy(name string) (uint8, bool) {
+	for policy, policyName := range policyNames {
+		if policyName == name {
+			return policy, true
+		}
+	}
+	return 0, false
+}
+
+// Names of the policies that are set
+func PolicyNames(policy uint8) []string {
+	names := []string{}
+	for _, p := range []uint8{PolicyFreeze, PolicyPause, PolicyAllowlist} {
+		if policy&p != 0 {
+			names = append(names, policyNames[p])
+		}
+	}
+	return names
+}
+
+type PolicyAction uint8
+
+const (
+	FreezeHolder PolicyAction = iota
+	UnfreezeHolder
+	PauseContract
+	UnpauseContract
+	AllowHolder
+	DisallowHolder
+)
+
+var actionNames = map[PolicyAction]string{
+	FreezeHolder:    "freeze",
+	UnfreezeHolder:  "unfreeze",
+	PauseContract:   "pause",
+	UnpauseContract: "unpause",
+	AllowHolder:     "allow",
+	DisallowHolder:  "disallow",
+}
+
+func ParsePolicyAction(name string) (PolicyAction, bool) {
+	for action, actionName := range actionNames {
+		if actionName == name {
+			return action, true
+		}
+	}
+	return 0, false
+}
+
+func (p PolicyAction) String() string {
+	return actionNames[p]
+}
+
+// The policy that the action belongs to
+func (p PolicyAction) Policy() uint8 {
+	switch p {
+	case FreezeHolder, UnfreezeHolder:
+		return PolicyFreeze
+	case PauseContract, UnpauseContract:
+		return PolicyPause
+	case AllowHolder, DisallowHolder:
+		return PolicyAllowlist
+	}
+	return 0
+}
+
+// Whether the action is applied to a holder
+func (p PolicyAction) HasHolder() bool {
+	return p != PauseContract && p != UnpauseContract
+}
+
+// The parties of a transaction that transfers contract coins, the
+// receiver of a burn transaction is empty.
+func TransferParties(tx ITransaction) (hasharry.Address, hasharry.Address, bool) {
+	switch tx.GetTxType() {
+	case NormalTransaction, TimeLockTransaction, HTLCLockTransaction:
+		return tx.From(), tx.GetTxBody().ToAddress(), true
+	case TransferFromTransaction:
+		body, ok := tx.GetTxBody().(*TransferFromBody)
+		if !ok {
+			return hasharry.Address{}, hasharry.Address{}, false
+		}
+		return body.Owner, body.To, true
+	case BurnTransaction:
+		return tx.From(), hasharry.Address{}, true
+	}
+	return hasharry.Address{}, hasharry.Address{}, false
+}
+
+// Verify that the transfer complies with the policies of the contract.
+// The issuer is always allowed by the allowlist.
+func (c *Contract) VerifyTransfer(from, to hasharry.Address) error {
+	if c.Paused {
+		return errors.New("the transfers of this contract are paused")
+	}
+	for _, holder := range []hasharry.Address{from, to} {
+		if hasharry.EmptyAddress(holder) {
+			continue
+		}
+		if c.isFrozen(holder.String()) {
+			return fmt.Errorf("holder %s is frozen", holder.String())
+		}
+		if c.Policy&PolicyAllowlist != 0 && !c.IsIssuer(holder) && !c.isAllowed(holder.String()) {
+			return fmt.Errorf("holder %s is not in the allowlist", holder.String())
+		}
+	}
+	return nil
+}
+
+// Verify the policy transaction, only the issuer can change the
+// policies selected when the contract was published.
+func (c *Contract) VerifyPolicy(tx ITransaction) error {
+	body, ok := tx.GetTxBody().(*ContractPolicyBody)
+	if !ok {
+		return ErrTxBody
+	}
+	if !c.IsIssuer(tx.From()) {
+		return errors.New("only the issuer can change the policy")
+	}
+	if c.Policy&body.Action.Policy() == 0 {
+		return fmt.Errorf("this contract does not support the %s policy", policyNames[body.Action.Policy()])
+	}
+	return nil
+}
+
+// Apply the policy transaction
+func (c *Contract) UpdatePolicy(tx ITransaction) error {
+	body, ok := tx.GetTxBody().(*ContractPolicyBody)
+	if !ok {
+		return ErrTxBody
+	}
+	holder := body.Holder.String()
+	switch body.Action {
+	case FreezeHolder:
+		c.Frozen = addHolder(c.Frozen, holder)
+	case UnfreezeHolder:
+		c.Frozen = removeHolder(c.Frozen, holder)
+	case PauseContract:
+		c.Paused = true
+	case UnpauseContract:
+		c.Paused = false
+	case AllowHolder:
+		c.Allowlist = addHolder(c.Allowlist, holder)
+	case DisallowHolder:
+		c.Allowlist = removeHolder(c.Allowlist, holder)
+	}
+	return nil
+}
+
+func (c *Contract) isFrozen(holder string) bool {
+	return containsHolder(c.Frozen, holder)
+}
+
+func (c *Contract) isAllowed(holder string) bool {
+	return containsHolder(c.Allowlist, holder)
+}
+
+func containsHolder(holders []string, holder string) bool {
+	for _, h := range holders {
+		if h == holder {
+			return true
+		}
+	}
+	return false
+}
+
+func addHolder(holders []string, holder string) []string {
+	if containsHolder(holders, holder) {
+		return holders
+	}
+	return append(holders, holder)
+}
+
+func removeHolder(holders []string, holder string) []string {
+	var list []string
+	for _, h := range holders {
+		if h != holder {
+			list = append(list, h)
+		}
+	}
+	return list
+}
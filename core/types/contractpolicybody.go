@@ -0,0 +1,65 @@
+package types
+
+import (
+	"errors"
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/param"
+	"github.com/uworldao/UWORLD/ut"
+)
+
+// Change the policy of a contract, such as freezing a holder,
+// pausing the transfers or adding a holder to the allowlist.
+type ContractPolicyBody struct {
+	Contract hasharry.Address
+	Action   PolicyAction
+	Holder   hasharry.Address
+}
+
+func (cb *ContractPolicyBody) ToAddress() hasharry.Address {
+	return cb.Holder
+}
+
+func (cb *ContractPolicyBody) GetAmount() uint64 {
+	return 0
+}
+
+func (cb *ContractPolicyBody) GetContract() hasharry.Address {
+	return cb.Contract
+}
+
+func (cb *ContractPolicyBody) GetName() string {
+	return ""
+}
+
+func (cb *ContractPolicyBody) GetAbbr() string {
+	return ""
+}
+
+func (cb *ContractPolicyBody) GetIncreaseSwitch() bool {
+	return false
+}
+
+func (cb *ContractPolicyBody) GetDescription() string {
+	return ""
+}
+
+func (cb *ContractPolicyBody) GetPeerId() []byte {
+	return nil
+}
+
+func (cb *ContractPolicyBody) VerifyBody(from hasharry.Address) error {
+	if cb.Contract.IsEqual(param.Token) || !ut.IsValidContractAddress(param.Net, cb.Contract.String()) {
+		return ErrContractAddr
+	}
+	if cb.Action.Policy() == 0 {
+		return errors.New("unknown policy action")
+	}
+	if cb.Action.HasHolder() {
+		if !ut.CheckUWDAddress(param.Net, cb.Holder.String()) {
+			return ErrAddress
+		}
+	} else if !hasharry.EmptyAddress(cb.Holder) {
+		return errors.New("the action does not need a holder")
+	}
+	return nil
+}
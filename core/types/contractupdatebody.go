@@ -0,0 +1,114 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/param"
+	"github.com/uworldao/UWORLD/ut"
+)
+
+const MaxMetadataURI = 256
+
+// Update the description and the metadata uri of a contract
+type ContractMetadataBody struct {
+	Contract    hasharry.Address
+	Description string
+	MetadataURI string
+}
+
+func (cb *ContractMetadataBody) ToAddress() hasharry.Address {
+	return hasharry.Address{}
+}
+
+func (cb *ContractMetadataBody) GetAmount() uint64 {
+	return 0
+}
+
+func (cb *ContractMetadataBody) GetContract() hasharry.Address {
+	return cb.Contract
+}
+
+func (cb *ContractMetadataBody) GetName() string {
+	return ""
+}
+
+func (cb *ContractMetadataBody) GetAbbr() string {
+	return ""
+}
+
+func (cb *ContractMetadataBody) GetIncreaseSwitch() bool {
+	return false
+}
+
+func (cb *ContractMetadataBody) GetDescription() string {
+	return cb.Description
+}
+
+func (cb *ContractMetadataBody) GetPeerId() []byte {
+	return nil
+}
+
+func (cb *ContractMetadataBody) VerifyBody(from hasharry.Address) error {
+	if cb.Contract.IsEqual(param.Token) || !ut.IsValidContractAddress(param.Net, cb.Contract.String()) {
+		return ErrContractAddr
+	}
+	if len(cb.Description) > MaxCoinDescription {
+		return fmt.Errorf("the maximum length of coin description shall not exceed %d", MaxCoinDescription)
+	}
+	if len(cb.MetadataURI) > MaxMetadataURI {
+		return fmt.Errorf("the maximum length of metadata uri shall not exceed %d", MaxMetadataURI)
+	}
+	return nil
+}
+
+// Transfer the issuer rights of a contract to another address
+type ContractIssuerBody struct {
+	Contract hasharry.Address
+	Issuer   hasharry.Address
+}
+
+func (cb *ContractIssuerBody) ToAddress() hasharry.Address {
+	return cb.Issuer
+}
+
+func (cb *ContractIssuerBody) GetAmount() uint64 {
+	return 0
+}
+
+func (cb *ContractIssuerBody) GetContract() hasharry.Address {
+	return cb.Contract
+}
+
+func (cb *ContractIssuerBody) GetName() string {
+	return ""
+}
+
+func (cb *ContractIssuerBody) GetAbbr() string {
+	return ""
+}
+
+func (cb *ContractIssuerBody) GetIncreaseSwitch() bool {
+	return false
+}
+
+func (cb *ContractIssuerBody) GetDescription() string {
+	return ""
+}
+
+func (cb *ContractIssuerBody) GetPeerId() []byte {
+	return nil
+}
+
+func (cb *ContractIssuerBody) VerifyBody(from hasharry.Address) error {
+	if cb.Contract.IsEqual(param.Token) || !ut.IsValidContractAddress(param.Net, cb.Contract.String()) {
+		return ErrContractAddr
+	}
+	if !ut.CheckUWDAddress(param.Net, cb.Issuer.String()) {
+		return ErrAddress
+	}
+	if cb.Issuer.IsEqual(from) {
+		return errors.New("the new issuer is the same as the sender")
+	}
+	return nil
+}
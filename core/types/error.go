@@ -9,6 +9,8 @@ import (
 var (
 	ErrSignature        = errors.New("signature verification failed")
 	ErrSigner           = errors.New("inconsistent signer")
+	ErrPayerSignature   = errors.New("payer signature verification failed")
+	ErrPayerSigner      = errors.New("inconsistent payer signer")
 	ErrNoSignature      = errors.New("no signature")
 	ErrWrongSignature   = errors.New("wrong signature")
 	ErrTxNonceRepeat    = errors.New("the nonce value is repeated, increase the nonce value")
@@ -25,4 +27,13 @@ var (
 	ErrContractAddr     = errors.New("wrong contract address")
 	ErrTxHead           = errors.New("transaction head cant be nil")
 	ErrTxBody           = errors.New("transaction body cant be nil")
+	ErrUnlockCondition  = errors.New("an unlock height or unlock time is required")
+	ErrNoHTLC           = errors.New("htlc is not exist")
+	ErrHTLCSettled      = errors.New("htlc has been claimed or refunded")
+	ErrPreimage         = errors.New("preimage does not match the hash lock")
+	ErrAllowance        = errors.New("allowance is not enough")
+	ErrNoName           = errors.New("name is not exist")
+	ErrTxExpired        = errors.New("transaction has expired")
+	ErrTxPoW            = errors.New("wrong transaction proof of work")
+	ErrNameExpired      = errors.New("name has expired")
 )
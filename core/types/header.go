@@ -36,6 +36,10 @@ type Header struct {
 	SignScript *SignScript
 	// Block generator
 	Signer hash2.Address
+	// HTLC status tree root hash, empty before any HTLC is created
+	HTLCRoot hash2.Hash `rlp:"optional"`
+	// Name status tree root hash, empty before any name is registered
+	NameRoot hash2.Hash `rlp:"optional"`
 }
 
 func (h *Header) ToBytes() []byte {
@@ -0,0 +1,116 @@
+package types
+
+import (
+	"crypto/sha256"
+	"errors"
+	"github.com/uworldao/UWORLD/common/hasharry"
+)
+
+const (
+	HTLCLocked   = "locked"
+	HTLCClaimed  = "claimed"
+	HTLCRefunded = "refunded"
+)
+
+// Hash time-locked contract. The amount is escrowed under the hash lock
+// until the recipient claims it with the preimage, or the sender takes it
+// back once the expire height is reached.
+type HTLC struct {
+	// Hash of the lock transaction
+	Id           hasharry.Hash
+	From         hasharry.Address
+	To           hasharry.Address
+	Contract     hasharry.Address
+	Amount       uint64
+	HashLock     hasharry.Hash
+	ExpireHeight uint64
+	Height       uint64
+	Status       string
+	// The preimage revealed by the claim
+	Preimage []byte
+	// Hash of the claim or refund transaction
+	SettleTx     hasharry.Hash
+	SettleHeight uint64
+}
+
+func NewHTLC(tx ITransaction, height uint64) *HTLC {
+	body := tx.GetTxBody().(*HTLCLockBody)
+	return &HTLC{
+		Id:           tx.Hash(),
+		From:         tx.From(),
+		To:           body.To,
+		Contract:     body.Contract,
+		Amount:       netAmount(tx),
+		HashLock:     body.HashLock,
+		ExpireHeight: body.ExpireHeight,
+		Height:       height,
+		Status:       HTLCLocked,
+	}
+}
+
+// Settle the HTLC by the claim or refund transaction
+func (h *HTLC) Settle(tx ITransaction, height uint64) {
+	if body, ok := tx.GetTxBody().(*HTLCClaimBody); ok {
+		h.Status = HTLCClaimed
+		h.Preimage = body.Preimage
+	} else {
+		h.Status = HTLCRefunded
+	}
+	h.SettleTx = tx.Hash()
+	h.SettleHeight = height
+}
+
+// The hash lock is the sha256 hash of the preimage, which is the
+// same as the hash locks used by other chains
+func HashLock(preimage []byte) hasharry.Hash {
+	return sha256.Sum256(preimage)
+}
+
+// Verify that the transaction can claim or refund the HTLC at the height
+func (h *HTLC) Verify(tx ITransaction, height uint64) error {
+	if h.Status != HTLCLocked {
+		return ErrHTLCSettled
+	}
+	switch body := tx.GetTxBody().(type) {
+	case *HTLCClaimBody:
+		if !tx.From().IsEqual(h.To) {
+			return errors.New("only the recipient can claim the htlc")
+		}
+		if height >= h.ExpireHeight {
+			return errors.New("htlc has expired")
+		}
+		if !HashLock(body.Preimage).IsEqual(h.HashLock) {
+			return ErrPreimage
+		}
+	case *HTLCRefundBody:
+		if !tx.From().IsEqual(h.From) {
+			return errors.New("only the sender can refund the htlc")
+		}
+		if height < h.ExpireHeight {
+			return errors.New("htlc has not expired")
+		}
+	default:
+		return ErrTxBody
+	}
+	return nil
+}
+
+// The address that receives the escrowed amount when the
+// transaction settles the HTLC
+func (h *HTLC) Receiver(tx ITransaction) hasharry.Address {
+	if tx.GetTxType() == HTLCClaimTransaction {
+		return h.To
+	}
+	return h.From
+}
+
+// Get the HTLC id of the claim or refund transaction
+func HTLCId(tx ITransaction) (hasharry.Hash, bool) {
+	switch body := tx.GetTxBody().(type) {
+	case *HTLCClaimBody:
+		return body.Id, true
+	case *HTLCRefundBody:
+		return body.Id, true
+	}
+	return hasharry.Hash{}, false
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/param"
+	"testing"
+)
+
+func TestHTLC_Verify(t *testing.T) {
+	from := hasharry.StringToAddress("UWDM1qcsk7UUNANMPKSpALJW7AqpDCy7tdoN")
+	to := hasharry.StringToAddress("UWDNQhgkNHCLdVhCFvpo6bGXXdcKtTTfeQZE")
+	preimage := []byte("secret")
+	lock := newTestTx(from, to, 1, 2*param.AtomsPerCoin)
+	lock.TxHead.TxType = HTLCLockTransaction
+	lock.TxBody = &HTLCLockBody{
+		Contract:     param.Token,
+		To:           to,
+		Amount:       2 * param.AtomsPerCoin,
+		HashLock:     HashLock(preimage),
+		ExpireHeight: 100,
+	}
+	lock.SetHash()
+	htlc := NewHTLC(lock, 10)
+	if htlc.Amount != 2*param.AtomsPerCoin-param.Fees {
+		t.Fatalf("wrong escrowed amount %d", htlc.Amount)
+	}
+
+	settle := func(sender hasharry.Address, body ITransactionBody, txType TransactionType) *Transaction {
+		tx := newTestTx(sender, hasharry.Address{}, 1, 0)
+		tx.TxHead.TxType = txType
+		tx.TxBody = body
+		return tx
+	}
+	claim := settle(to, &HTLCClaimBody{Id: htlc.Id, Preimage: preimage}, HTLCClaimTransaction)
+	if err := htlc.Verify(settle(to, &HTLCClaimBody{Id: htlc.Id, Preimage: []byte("wrong")}, HTLCClaimTransaction), 50); err != ErrPreimage {
+		t.Fatalf("expected ErrPreimage, got %v", err)
+	}
+	if err := htlc.Verify(settle(from, &HTLCClaimBody{Id: htlc.Id, Preimage: preimage}, HTLCClaimTransaction), 50); err == nil {
+		t.Fatal("only the recipient can claim")
+	}
+	if err := htlc.Verify(claim, 100); err == nil {
+		t.Fatal("expired htlc cannot be claimed")
+	}
+	refund := settle(from, &HTLCRefundBody{Id: htlc.Id}, HTLCRefundTransaction)
+	if err := htlc.Verify(refund, 99); err == nil {
+		t.Fatal("htlc cannot be refunded before expiry")
+	}
+
+	if err := htlc.Verify(claim, 50); err != nil {
+		t.Fatal(err)
+	}
+	htlc.Settle(claim, 50)
+	if htlc.Status != HTLCClaimed || !htlc.Receiver(claim).IsEqual(to) {
+		t.Fatal("htlc should be claimed by the recipient")
+	}
+	if err := htlc.Verify(refund, 100); err != ErrHTLCSettled {
+		t.Fatalf("expected ErrHTLCSettled, got %v", err)
+	}
+}
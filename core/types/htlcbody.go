@@ -0,0 +1,160 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/param"
+	"github.com/uworldao/UWORLD/ut"
+)
+
+const MaxPreimage = 64
+
+// Escrow the amount under the hash lock until the expire height
+type HTLCLockBody struct {
+	Contract     hasharry.Address
+	To           hasharry.Address
+	Amount       uint64
+	HashLock     hasharry.Hash
+	ExpireHeight uint64
+}
+
+func (hb *HTLCLockBody) ToAddress() hasharry.Address {
+	return hb.To
+}
+
+func (hb *HTLCLockBody) GetAmount() uint64 {
+	return hb.Amount
+}
+
+func (hb *HTLCLockBody) GetContract() hasharry.Address {
+	return hb.Contract
+}
+
+func (hb *HTLCLockBody) GetName() string {
+	return ""
+}
+
+func (hb *HTLCLockBody) GetAbbr() string {
+	return ""
+}
+
+func (hb *HTLCLockBody) GetIncreaseSwitch() bool {
+	return false
+}
+
+func (hb *HTLCLockBody) GetDescription() string {
+	return ""
+}
+
+func (hb *HTLCLockBody) GetPeerId() []byte {
+	return nil
+}
+
+func (hb *HTLCLockBody) VerifyBody(from hasharry.Address) error {
+	if !ut.IsValidContractAddress(param.Net, hb.Contract.String()) {
+		return ErrContractAddr
+	}
+	if !ut.CheckUWDAddress(param.Net, hb.To.String()) {
+		return ErrAddress
+	}
+	if hasharry.EmptyHash(hb.HashLock) {
+		return errors.New("hash lock is required")
+	}
+	if hb.ExpireHeight == 0 {
+		return errors.New("expire height is required")
+	}
+	return nil
+}
+
+// Claim the escrowed amount with the preimage of the hash lock
+type HTLCClaimBody struct {
+	Id       hasharry.Hash
+	Preimage []byte
+}
+
+func (hb *HTLCClaimBody) ToAddress() hasharry.Address {
+	return hasharry.Address{}
+}
+
+func (hb *HTLCClaimBody) GetAmount() uint64 {
+	return 0
+}
+
+func (hb *HTLCClaimBody) GetContract() hasharry.Address {
+	return param.Token
+}
+
+func (hb *HTLCClaimBody) GetName() string {
+	return ""
+}
+
+func (hb *HTLCClaimBody) GetAbbr() string {
+	return ""
+}
+
+func (hb *HTLCClaimBody) GetIncreaseSwitch() bool {
+	return false
+}
+
+func (hb *HTLCClaimBody) GetDescription() string {
+	return ""
+}
+
+func (hb *HTLCClaimBody) GetPeerId() []byte {
+	return nil
+}
+
+func (hb *HTLCClaimBody) VerifyBody(from hasharry.Address) error {
+	if hasharry.EmptyHash(hb.Id) {
+		return ErrNoHTLC
+	}
+	if len(hb.Preimage) == 0 || len(hb.Preimage) > MaxPreimage {
+		return fmt.Errorf("the length of the preimage must be between 1 and %d", MaxPreimage)
+	}
+	return nil
+}
+
+// Take back the escrowed amount after the expire height
+type HTLCRefundBody struct {
+	Id hasharry.Hash
+}
+
+func (hb *HTLCRefundBody) ToAddress() hasharry.Address {
+	return hasharry.Address{}
+}
+
+func (hb *HTLCRefundBody) GetAmount() uint64 {
+	return 0
+}
+
+func (hb *HTLCRefundBody) GetContract() hasharry.Address {
+	return param.Token
+}
+
+func (hb *HTLCRefundBody) GetName() string {
+	return ""
+}
+
+func (hb *HTLCRefundBody) GetAbbr() string {
+	return ""
+}
+
+func (hb *HTLCRefundBody) GetIncreaseSwitch() bool {
+	return false
+}
+
+func (hb *HTLCRefundBody) GetDescription() string {
+	return ""
+}
+
+func (hb *HTLCRefundBody) GetPeerId() []byte {
+	return nil
+}
+
+func (hb *HTLCRefundBody) VerifyBody(from hasharry.Address) error {
+	if hasharry.EmptyHash(hb.Id) {
+		return ErrNoHTLC
+	}
+	return nil
+}
@@ -8,14 +8,21 @@ import (
 type IAccount interface {
 	GetBalance(contract string) uint64
 	GetNonce() uint64
-	Update(confirmedHeight uint64) error
+	Update(confirmedHeight, blockTime uint64) error
 	StateKey() hasharry.Address
 	IsExist() bool
 	IsNeedUpdate() bool
 	FromChange(tx ITransaction, blockHeight uint64) error
 	ToChange(tx ITransaction, blockHeight uint64) error
+	ReceiveChange(address, contract hasharry.Address, amount, blockHeight uint64)
+	PayFees(tx ITransaction, blockHeight uint64) error
+	VerifyPayFees(tx ITransaction) error
 	FeesChange(fees, blockHeight uint64)
 	ConsumptionChange(fees, blockHeight uint64)
+	BurnedChange(tx ITransaction, blockHeight uint64)
+	SpendAllowance(tx ITransaction) error
+	VerifyAllowance(tx ITransaction) error
+	VerifySigner(signer hasharry.Address, pubKey []byte) bool
 	VerifyTxState(tx ITransaction) error
 	VerifyNonce(nonce uint64) error
 	IsEmpty() bool
@@ -12,6 +12,7 @@ type ITransaction interface {
 	VerifyCoinBaseTx(height, sumFees uint64) error
 	EncodeToBytes() ([]byte, error)
 	SignTx(key *secp256k1.PrivateKey) error
+	SignPayer(key *secp256k1.PrivateKey) error
 	SetHash() error
 	NonceKey() string
 	TranslateToRlpTransaction() *RlpTransaction
@@ -19,6 +20,11 @@ type ITransaction interface {
 	Hash() hasharry.Hash
 	From() hasharry.Address
 	GetFees() uint64
+	IsSponsored() bool
+	IsExpired(height uint64) bool
+	HasPoW() bool
+	VerifyMinFees(minFees uint64) error
+	GetPayer() hasharry.Address
 	GetNonce() uint64
 	GetTime() uint64
 	GetTxType() TransactionType
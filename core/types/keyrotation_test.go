@@ -0,0 +1,143 @@
+package types
+
+import (
+	"github.com/uworldao/UWORLD/common/encode/rlp"
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/crypto/ecc/secp256k1"
+	"github.com/uworldao/UWORLD/param"
+	"github.com/uworldao/UWORLD/ut"
+	"testing"
+)
+
+func TestAccount_KeyRotation(t *testing.T) {
+	oldKey, _ := secp256k1.GeneratePrivateKey()
+	newKey, _ := secp256k1.GeneratePrivateKey()
+	from := hasharry.StringToAddress(ut.GenerateUWDAddress(param.Net, oldKey.PubKey()))
+	account := NewAccount()
+	account.Address = from
+	tokenAccount, _ := account.Coins.Get(param.Token.String())
+	tokenAccount.Balance = 10 * param.AtomsPerCoin
+
+	if !account.VerifySigner(from, oldKey.PubKey().SerializeCompressed()) {
+		t.Fatal("the key of the address should control the account")
+	}
+	if account.VerifySigner(from, newKey.PubKey().SerializeCompressed()) {
+		t.Fatal("the new key is not bound yet")
+	}
+
+	tx := newTestTx(from, hasharry.Address{}, 1, 0)
+	tx.TxHead.TxType = KeyRotationTransaction
+	tx.TxBody = &KeyRotationBody{PubKey: newKey.PubKey().SerializeCompressed()}
+	tx.SetHash()
+	tx.SignTx(oldKey)
+	if err := tx.VerifyTx(); err != nil {
+		t.Fatal(err)
+	}
+	if err := account.FromChange(tx, 5); err != nil {
+		t.Fatal(err)
+	}
+	if account.GetBalance(param.Token.String()) != 10*param.AtomsPerCoin-param.Fees {
+		t.Fatalf("wrong balance %d", account.GetBalance(param.Token.String()))
+	}
+	if account.VerifySigner(from, oldKey.PubKey().SerializeCompressed()) {
+		t.Fatal("the old key should not control the account")
+	}
+	if !account.VerifySigner(from, newKey.PubKey().SerializeCompressed()) {
+		t.Fatal("the new key should control the account")
+	}
+
+	// The binding is kept in the account state
+	bytes, err := rlp.EncodeToBytes(account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded *Account
+	if err := rlp.DecodeBytes(bytes, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.VerifySigner(from, newKey.PubKey().SerializeCompressed()) {
+		t.Fatal("the binding is lost")
+	}
+
+	// A transaction signed by the new key is valid for the address
+	next := newTestTx(from, from, 2, param.AtomsPerCoin)
+	next.SetHash()
+	next.SignTx(newKey)
+	if err := next.VerifyTx(); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.VerifySigner(next.From(), next.GetSignScript().PubKey) {
+		t.Fatal("the signer should be accepted")
+	}
+
+	block := &Block{Header: &Header{}, Body: &Body{Transactions: Transactions{tx, next}}}
+	pubKeys := block.GetPubKeys()
+	if string(pubKeys[from]) != string(newKey.PubKey().SerializeCompressed()) {
+		t.Fatal("the bound key should be indexed")
+	}
+}
+
+func TestRotations_VerifySigners(t *testing.T) {
+	oldKey, _ := secp256k1.GeneratePrivateKey()
+	newKey, _ := secp256k1.GeneratePrivateKey()
+	from := hasharry.StringToAddress(ut.GenerateUWDAddress(param.Net, oldKey.PubKey()))
+	senderKey, _ := secp256k1.GeneratePrivateKey()
+	sender := hasharry.StringToAddress(ut.GenerateUWDAddress(param.Net, senderKey.PubKey()))
+
+	rotation := newTestTx(from, hasharry.Address{}, 1, 0)
+	rotation.TxHead.TxType = KeyRotationTransaction
+	rotation.TxBody = &KeyRotationBody{PubKey: newKey.PubKey().SerializeCompressed()}
+	rotation.SetHash()
+	rotation.SignTx(oldKey)
+
+	rotations := make(Rotations)
+	if err := rotations.VerifySigners(rotation); err != nil {
+		t.Fatal(err)
+	}
+	rotations.Add(rotation)
+
+	// The replaced key can not sign after the rotation in the same block
+	stale := newTestTx(from, from, 2, param.AtomsPerCoin)
+	stale.SetHash()
+	stale.SignTx(oldKey)
+	if err := rotations.VerifySigners(stale); err != ErrSigner {
+		t.Fatalf("expected ErrSigner, got %v", err)
+	}
+	next := newTestTx(from, from, 2, param.AtomsPerCoin)
+	next.SetHash()
+	next.SignTx(newKey)
+	if err := rotations.VerifySigners(next); err != nil {
+		t.Fatal(err)
+	}
+
+	// Neither can it pay for the transaction of another address
+	sponsored := newTestTx(sender, sender, 1, param.AtomsPerCoin)
+	sponsored.TxHead.Payer = from
+	sponsored.SetHash()
+	sponsored.SignTx(senderKey)
+	sponsored.SignPayer(oldKey)
+	if err := rotations.VerifySigners(sponsored); err != ErrPayerSigner {
+		t.Fatalf("expected ErrPayerSigner, got %v", err)
+	}
+	sponsored.SignPayer(newKey)
+	if err := rotations.VerifySigners(sponsored); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestKeyRotationBody_VerifyBody(t *testing.T) {
+	key, _ := secp256k1.GeneratePrivateKey()
+	from := hasharry.StringToAddress(ut.GenerateUWDAddress(param.Net, key.PubKey()))
+	body := &KeyRotationBody{PubKey: key.PubKey().SerializeCompressed()}
+	if err := body.VerifyBody(from); err != nil {
+		t.Fatal(err)
+	}
+	body.PubKey = key.PubKey().SerializeUncompressed()
+	if err := body.VerifyBody(from); err == nil {
+		t.Fatal("an uncompressed key should be rejected")
+	}
+	body.PubKey = make([]byte, 33)
+	if err := body.VerifyBody(from); err == nil {
+		t.Fatal("an invalid key should be rejected")
+	}
+}
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/crypto/ecc/secp256k1"
+)
+
+// Bind a new public key to the address of the sender, the key
+// controls the address instead of the key of the address, and
+// the balances and other states of the address are kept.
+type KeyRotationBody struct {
+	PubKey []byte
+}
+
+func (kb *KeyRotationBody) ToAddress() hasharry.Address {
+	return hasharry.Address{}
+}
+
+func (kb *KeyRotationBody) GetAmount() uint64 {
+	return 0
+}
+
+func (kb *KeyRotationBody) GetContract() hasharry.Address {
+	return hasharry.Address{}
+}
+
+func (kb *KeyRotationBody) GetName() string {
+	return ""
+}
+
+func (kb *KeyRotationBody) GetAbbr() string {
+	return ""
+}
+
+func (kb *KeyRotationBody) GetIncreaseSwitch() bool {
+	return false
+}
+
+func (kb *KeyRotationBody) GetDescription() string {
+	return ""
+}
+
+func (kb *KeyRotationBody) GetPeerId() []byte {
+	return nil
+}
+
+func (kb *KeyRotationBody) VerifyBody(from hasharry.Address) error {
+	if len(kb.PubKey) != secp256k1.PubKeyBytesLenCompressed {
+		return errors.New("the public key must be compressed")
+	}
+	if _, err := secp256k1.ParsePubKey(kb.PubKey); err != nil {
+		return errors.New("wrong public key")
+	}
+	return nil
+}
+
+// The keys bound by the key rotations of a block. A rotation changes
+// the account state only when the block is applied, so the following
+// transactions of the address in the same block are checked here.
+type Rotations map[hasharry.Address][]byte
+
+// Record the key bound by a key rotation transaction
+func (r Rotations) Add(tx ITransaction) {
+	if txBody, ok := tx.GetTxBody().(*KeyRotationBody); ok {
+		r[tx.From()] = txBody.PubKey
+	}
+}
+
+// Verify that the sender and the payer sign the transaction with the
+// keys bound earlier in the block instead of the replaced keys
+func (r Rotations) VerifySigners(tx ITransaction) error {
+	if key, ok := r[tx.From()]; ok {
+		if signScript := tx.GetSignScript(); signScript == nil || !isBoundKey(signScript.PubKey, key) {
+			return ErrSigner
+		}
+	}
+	if payerTx, ok := tx.(*Transaction); ok && payerTx.IsSponsored() {
+		if key, ok := r[payerTx.GetPayer()]; ok {
+			if signScript := payerTx.TxHead.PayerSignScript; signScript == nil || !isBoundKey(signScript.PubKey, key) {
+				return ErrPayerSigner
+			}
+		}
+	}
+	return nil
+}
+
+func isBoundKey(pubKey []byte, bound []byte) bool {
+	key, err := secp256k1.ParsePubKey(pubKey)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(key.SerializeCompressed(), bound)
+}
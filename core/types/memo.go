@@ -0,0 +1,31 @@
+package types
+
+import (
+	"fmt"
+	"github.com/uworldao/UWORLD/crypto/ecc/secp256k1"
+)
+
+// Maximum length of the encrypted memo, which holds a
+// note of at most MaxNote bytes
+const MaxMemo = 512
+
+// Encrypt the note with the public key of the receiver by ECIES
+func EncryptMemo(pubKey []byte, note string) ([]byte, error) {
+	if len(note) > MaxNote {
+		return nil, fmt.Errorf("the length of the memo must not be greater than %d", MaxNote)
+	}
+	key, err := secp256k1.ParsePubKey(pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("wrong public key, %s", err.Error())
+	}
+	return secp256k1.Encrypt(key, []byte(note))
+}
+
+// Decrypt the memo with the private key of the receiver
+func DecryptMemo(key *secp256k1.PrivateKey, memo []byte) (string, error) {
+	note, err := secp256k1.Decrypt(key, memo)
+	if err != nil {
+		return "", err
+	}
+	return string(note), nil
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"github.com/uworldao/UWORLD/common/encode/rlp"
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/crypto/ecc/secp256k1"
+	"github.com/uworldao/UWORLD/param"
+	"github.com/uworldao/UWORLD/ut"
+	"testing"
+)
+
+func TestTransaction_Memo(t *testing.T) {
+	fromKey, _ := secp256k1.GeneratePrivateKey()
+	toKey, _ := secp256k1.GeneratePrivateKey()
+	otherKey, _ := secp256k1.GeneratePrivateKey()
+	from := hasharry.StringToAddress(ut.GenerateUWDAddress(param.Net, fromKey.PubKey()))
+	to := hasharry.StringToAddress(ut.GenerateUWDAddress(param.Net, toKey.PubKey()))
+
+	tx := newTestTx(from, to, 1, param.AtomsPerCoin)
+	memo, err := EncryptMemo(toKey.PubKey().SerializeCompressed(), "invoice 2020-0815")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx.TxHead.Memo = memo
+	tx.SetHash()
+	tx.SignTx(fromKey)
+	if err := tx.VerifyTx(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The memo survives the rlp and rpc encodings
+	bytes, _ := rlp.EncodeToBytes(tx.TranslateToRlpTransaction())
+	var rlpTx *RlpTransaction
+	if err := rlp.DecodeBytes(bytes, &rlpTx); err != nil {
+		t.Fatal(err)
+	}
+	rpcTx, _ := TranslateTxToRpcTx(rlpTx.TranslateToTransaction())
+	decoded, err := TranslateRpcTxToTx(rpcTx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := decoded.VerifyTx(); err != nil {
+		t.Fatal(err)
+	}
+	note, err := DecryptMemo(toKey, decoded.TxHead.Memo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if note != "invoice 2020-0815" {
+		t.Fatalf("wrong memo %s", note)
+	}
+	if _, err := DecryptMemo(otherKey, decoded.TxHead.Memo); err == nil {
+		t.Fatal("only the receiver can decrypt the memo")
+	}
+
+	tx.TxHead.Memo = make([]byte, MaxMemo+1)
+	if err := tx.verifyTxNote(); err == nil {
+		t.Fatal("the memo is too long")
+	}
+}
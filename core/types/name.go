@@ -0,0 +1,84 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/param"
+)
+
+const (
+	MinNameLength = 3
+	MaxNameLength = 32
+)
+
+// A name registered for an address, the name can be renewed
+// and transferred by the owner before it expires, after that
+// anyone can register it again.
+type Name struct {
+	Name  string
+	Owner hasharry.Address
+	// Height of the block that registered the name
+	Height       uint64
+	ExpireHeight uint64
+}
+
+func NewName(tx ITransaction, height uint64) *Name {
+	return &Name{
+		Name:         tx.GetTxBody().(*NameRegisterBody).Name,
+		Owner:        tx.From(),
+		Height:       height,
+		ExpireHeight: height + param.NamePeriod,
+	}
+}
+
+func (n *Name) IsExpired(height uint64) bool {
+	return height >= n.ExpireHeight
+}
+
+// Verify that the renewal or transfer is sent by the owner before
+// the name expires
+func (n *Name) Verify(tx ITransaction, height uint64) error {
+	if n.IsExpired(height) {
+		return ErrNameExpired
+	}
+	if !n.Owner.IsEqual(tx.From()) {
+		return errors.New("only the owner can change the name")
+	}
+	return nil
+}
+
+// Renew the name for another period from the current expire height
+func (n *Name) Renew() {
+	n.ExpireHeight += param.NamePeriod
+}
+
+// The name consists of 3 to 32 lowercase letters, digits and
+// hyphens, and does not start or end with a hyphen.
+func CheckName(name string) error {
+	if len(name) < MinNameLength || len(name) > MaxNameLength {
+		return fmt.Errorf("the name length must be in the range of %d and %d", MinNameLength, MaxNameLength)
+	}
+	for _, c := range name {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '-' {
+			return errors.New("the name can only contain lowercase letters, digits and hyphens")
+		}
+	}
+	if name[0] == '-' || name[len(name)-1] == '-' {
+		return errors.New("the name cannot start or end with a hyphen")
+	}
+	return nil
+}
+
+// Get the name of a name transaction
+func TxName(tx ITransaction) (string, bool) {
+	switch body := tx.GetTxBody().(type) {
+	case *NameRegisterBody:
+		return body.Name, true
+	case *NameRenewBody:
+		return body.Name, true
+	case *NameTransferBody:
+		return body.Name, true
+	}
+	return "", false
+}
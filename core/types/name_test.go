@@ -0,0 +1,49 @@
+package types
+
+import (
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/param"
+	"testing"
+)
+
+func TestCheckName(t *testing.T) {
+	for _, name := range []string{"abc", "alice-01", "0x0"} {
+		if err := CheckName(name); err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+	}
+	for _, name := range []string{"ab", "Alice", "-abc", "abc-", "a.b.c", "abcdefghijklmnopqrstuvwxyz0123456"} {
+		if err := CheckName(name); err == nil {
+			t.Fatalf("%s is an invalid name", name)
+		}
+	}
+}
+
+func TestName_Verify(t *testing.T) {
+	owner := hasharry.StringToAddress("UWDM1qcsk7UUNANMPKSpALJW7AqpDCy7tdoN")
+	other := hasharry.StringToAddress("UWDNQhgkNHCLdVhCFvpo6bGXXdcKtTTfeQZE")
+	nameTx := func(from hasharry.Address, txType TransactionType, body ITransactionBody) *Transaction {
+		tx := newTestTx(from, from, 1, 0)
+		tx.TxHead.TxType = txType
+		tx.TxBody = body
+		return tx
+	}
+	name := NewName(nameTx(owner, NameRegisterTransaction, &NameRegisterBody{Name: "alice"}), 10)
+	if !name.Owner.IsEqual(owner) || name.ExpireHeight != 10+param.NamePeriod {
+		t.Fatal("wrong registered name")
+	}
+	renew := nameTx(owner, NameRenewTransaction, &NameRenewBody{Name: "alice"})
+	if err := name.Verify(renew, 11); err != nil {
+		t.Fatal(err)
+	}
+	if err := name.Verify(nameTx(other, NameRenewTransaction, &NameRenewBody{Name: "alice"}), 11); err == nil {
+		t.Fatal("only the owner can renew the name")
+	}
+	name.Renew()
+	if name.IsExpired(10+param.NamePeriod) || !name.IsExpired(10+2*param.NamePeriod) {
+		t.Fatal("wrong expire height after renewal")
+	}
+	if err := name.Verify(renew, 10+2*param.NamePeriod); err != ErrNameExpired {
+		t.Fatal("an expired name cannot be renewed")
+	}
+}
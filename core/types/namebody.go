@@ -0,0 +1,137 @@
+package types
+
+import (
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/param"
+	"github.com/uworldao/UWORLD/ut"
+)
+
+// Register the name for the sender
+type NameRegisterBody struct {
+	Name string
+}
+
+func (nb *NameRegisterBody) ToAddress() hasharry.Address {
+	return hasharry.Address{}
+}
+
+func (nb *NameRegisterBody) GetAmount() uint64 {
+	return 0
+}
+
+func (nb *NameRegisterBody) GetContract() hasharry.Address {
+	return param.Token
+}
+
+func (nb *NameRegisterBody) GetName() string {
+	return nb.Name
+}
+
+func (nb *NameRegisterBody) GetAbbr() string {
+	return ""
+}
+
+func (nb *NameRegisterBody) GetIncreaseSwitch() bool {
+	return false
+}
+
+func (nb *NameRegisterBody) GetDescription() string {
+	return ""
+}
+
+func (nb *NameRegisterBody) GetPeerId() []byte {
+	return nil
+}
+
+func (nb *NameRegisterBody) VerifyBody(from hasharry.Address) error {
+	return CheckName(nb.Name)
+}
+
+// Renew the name owned by the sender for another period
+type NameRenewBody struct {
+	Name string
+}
+
+func (nb *NameRenewBody) ToAddress() hasharry.Address {
+	return hasharry.Address{}
+}
+
+func (nb *NameRenewBody) GetAmount() uint64 {
+	return 0
+}
+
+func (nb *NameRenewBody) GetContract() hasharry.Address {
+	return param.Token
+}
+
+func (nb *NameRenewBody) GetName() string {
+	return nb.Name
+}
+
+func (nb *NameRenewBody) GetAbbr() string {
+	return ""
+}
+
+func (nb *NameRenewBody) GetIncreaseSwitch() bool {
+	return false
+}
+
+func (nb *NameRenewBody) GetDescription() string {
+	return ""
+}
+
+func (nb *NameRenewBody) GetPeerId() []byte {
+	return nil
+}
+
+func (nb *NameRenewBody) VerifyBody(from hasharry.Address) error {
+	return CheckName(nb.Name)
+}
+
+// Transfer the name owned by the sender to another address
+type NameTransferBody struct {
+	Name string
+	To   hasharry.Address
+}
+
+func (nb *NameTransferBody) ToAddress() hasharry.Address {
+	return nb.To
+}
+
+func (nb *NameTransferBody) GetAmount() uint64 {
+	return 0
+}
+
+func (nb *NameTransferBody) GetContract() hasharry.Address {
+	return param.Token
+}
+
+func (nb *NameTransferBody) GetName() string {
+	return nb.Name
+}
+
+func (nb *NameTransferBody) GetAbbr() string {
+	return ""
+}
+
+func (nb *NameTransferBody) GetIncreaseSwitch() bool {
+	return false
+}
+
+func (nb *NameTransferBody) GetDescription() string {
+	return ""
+}
+
+func (nb *NameTransferBody) GetPeerId() []byte {
+	return nil
+}
+
+func (nb *NameTransferBody) VerifyBody(from hasharry.Address) error {
+	if err := CheckName(nb.Name); err != nil {
+		return err
+	}
+	if !ut.CheckUWDAddress(param.Net, nb.To.String()) {
+		return ErrAddress
+	}
+	return nil
+}
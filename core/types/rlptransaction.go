@@ -30,6 +30,111 @@ func (rt *RlpTransaction) TranslateToTransaction() *Transaction {
 			TxHead: rt.TxHead,
 			TxBody: nt,
 		}
+	case TimeLockTransaction:
+		var tt *TimeLockTransactionBody
+		rlp.DecodeBytes(rt.TxBody, &tt)
+		return &Transaction{
+			TxHead: rt.TxHead,
+			TxBody: tt,
+		}
+	case BurnTransaction:
+		var bb *BurnBody
+		rlp.DecodeBytes(rt.TxBody, &bb)
+		return &Transaction{
+			TxHead: rt.TxHead,
+			TxBody: bb,
+		}
+	case ApproveTransaction:
+		var ab *ApproveBody
+		rlp.DecodeBytes(rt.TxBody, &ab)
+		return &Transaction{
+			TxHead: rt.TxHead,
+			TxBody: ab,
+		}
+	case TransferFromTransaction:
+		var tb *TransferFromBody
+		rlp.DecodeBytes(rt.TxBody, &tb)
+		return &Transaction{
+			TxHead: rt.TxHead,
+			TxBody: tb,
+		}
+	case ContractPolicyTransaction:
+		var cb *ContractPolicyBody
+		rlp.DecodeBytes(rt.TxBody, &cb)
+		return &Transaction{
+			TxHead: rt.TxHead,
+			TxBody: cb,
+		}
+	case ContractMetadataTransaction:
+		var cb *ContractMetadataBody
+		rlp.DecodeBytes(rt.TxBody, &cb)
+		return &Transaction{
+			TxHead: rt.TxHead,
+			TxBody: cb,
+		}
+	case ContractIssuerTransaction:
+		var cb *ContractIssuerBody
+		rlp.DecodeBytes(rt.TxBody, &cb)
+		return &Transaction{
+			TxHead: rt.TxHead,
+			TxBody: cb,
+		}
+	case NameRegisterTransaction:
+		var nb *NameRegisterBody
+		rlp.DecodeBytes(rt.TxBody, &nb)
+		return &Transaction{
+			TxHead: rt.TxHead,
+			TxBody: nb,
+		}
+	case NameRenewTransaction:
+		var nb *NameRenewBody
+		rlp.DecodeBytes(rt.TxBody, &nb)
+		return &Transaction{
+			TxHead: rt.TxHead,
+			TxBody: nb,
+		}
+	case NameTransferTransaction:
+		var nb *NameTransferBody
+		rlp.DecodeBytes(rt.TxBody, &nb)
+		return &Transaction{
+			TxHead: rt.TxHead,
+			TxBody: nb,
+		}
+	case AnchorTransaction:
+		var ab *AnchorBody
+		rlp.DecodeBytes(rt.TxBody, &ab)
+		return &Transaction{
+			TxHead: rt.TxHead,
+			TxBody: ab,
+		}
+	case KeyRotationTransaction:
+		var kb *KeyRotationBody
+		rlp.DecodeBytes(rt.TxBody, &kb)
+		return &Transaction{
+			TxHead: rt.TxHead,
+			TxBody: kb,
+		}
+	case HTLCLockTransaction:
+		var hb *HTLCLockBody
+		rlp.DecodeBytes(rt.TxBody, &hb)
+		return &Transaction{
+			TxHead: rt.TxHead,
+			TxBody: hb,
+		}
+	case HTLCClaimTransaction:
+		var hb *HTLCClaimBody
+		rlp.DecodeBytes(rt.TxBody, &hb)
+		return &Transaction{
+			TxHead: rt.TxHead,
+			TxBody: hb,
+		}
+	case HTLCRefundTransaction:
+		var hb *HTLCRefundBody
+		rlp.DecodeBytes(rt.TxBody, &hb)
+		return &Transaction{
+			TxHead: rt.TxHead,
+			TxBody: hb,
+		}
 		/*case LogoutCandidate:
 			return &Transaction{
 				TxHead: rt.TxHead,
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"time"
+)
+
+type RpcAnchor struct {
+	Digest    string      `json:"digest"`
+	Height    uint64      `json:"height"`
+	Time      time.Time   `json:"time"`
+	TxHash    string      `json:"txhash"`
+	BlockHash string      `json:"blockhash"`
+	Proof     *RpcTxProof `json:"proof"`
+}
+
+type RpcTxProof struct {
+	TxRoot   string   `json:"txroot"`
+	TxIndex  uint32   `json:"txindex"`
+	TxHashes []string `json:"txhashes"`
+}
+
+func TranslateAnchorToRpcAnchor(anchor *Anchor) *RpcAnchor {
+	txHashes := make([]string, len(anchor.Proof.TxHashes))
+	for i, txHash := range anchor.Proof.TxHashes {
+		txHashes[i] = txHash.String()
+	}
+	return &RpcAnchor{
+		Digest:    anchor.Digest.String(),
+		Height:    anchor.Header.Height,
+		Time:      time.Unix(int64(anchor.Header.Time), 0),
+		TxHash:    anchor.TxHash.String(),
+		BlockHash: anchor.Header.HashString(),
+		Proof: &RpcTxProof{
+			TxRoot:   anchor.Header.TxRoot.String(),
+			TxIndex:  anchor.Proof.TxIndex,
+			TxHashes: txHashes,
+		},
+	}
+}
@@ -0,0 +1,14 @@
+package types
+
+type RpcApproveBody struct {
+	Contract  string `json:"contract"`
+	Spender   string `json:"spender"`
+	Allowance uint64 `json:"allowance"`
+}
+
+type RpcTransferFromBody struct {
+	Contract string `json:"contract"`
+	Owner    string `json:"owner"`
+	To       string `json:"to"`
+	Amount   uint64 `json:"amount"`
+}
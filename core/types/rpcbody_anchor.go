@@ -0,0 +1,5 @@
+package types
+
+type RpcAnchorBody struct {
+	Digests []string `json:"digests"`
+}
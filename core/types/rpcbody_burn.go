@@ -0,0 +1,6 @@
+package types
+
+type RpcBurnBody struct {
+	Contract string `json:"contract"`
+	Amount   uint64 `json:"amount"`
+}
@@ -8,4 +8,23 @@ type RpcContractTransactionBody struct {
 	Description string `json:"description"`
 	Increase    bool   `json:"increase"`
 	Amount      uint64 `json:"amount"`
+	// Names of the policies selected by the issuer
+	Policies []string `json:"policies,omitempty"`
+}
+
+type RpcContractPolicyBody struct {
+	Contract string `json:"contract"`
+	Action   string `json:"action"`
+	Holder   string `json:"holder"`
+}
+
+type RpcContractMetadataBody struct {
+	Contract    string `json:"contract"`
+	Description string `json:"description"`
+	MetadataURI string `json:"metadatauri"`
+}
+
+type RpcContractIssuerBody struct {
+	Contract string `json:"contract"`
+	Issuer   string `json:"issuer"`
 }
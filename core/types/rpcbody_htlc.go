@@ -0,0 +1,18 @@
+package types
+
+type RpcHTLCLockBody struct {
+	Contract     string `json:"contract"`
+	To           string `json:"to"`
+	Amount       uint64 `json:"amount"`
+	HashLock     string `json:"hashlock"`
+	ExpireHeight uint64 `json:"expireheight"`
+}
+
+type RpcHTLCClaimBody struct {
+	Id       string `json:"id"`
+	Preimage string `json:"preimage"`
+}
+
+type RpcHTLCRefundBody struct {
+	Id string `json:"id"`
+}
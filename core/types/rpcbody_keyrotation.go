@@ -0,0 +1,5 @@
+package types
+
+type RpcKeyRotationBody struct {
+	PubKey string `json:"pubkey"`
+}
@@ -0,0 +1,6 @@
+package types
+
+type RpcNameBody struct {
+	Name string `json:"name"`
+	To   string `json:"to,omitempty"`
+}
@@ -0,0 +1,13 @@
+package types
+
+type RpcTimeLockTransactionBody struct {
+	Contract     string `json:"contract"`
+	To           string `json:"to"`
+	Amount       uint64 `json:"amount"`
+	UnlockHeight uint64 `json:"unlockheight"`
+	UnlockTime   uint64 `json:"unlocktime"`
+}
+
+func (rtb *RpcTimeLockTransactionBody) ToBytes() []byte {
+	return []byte(rtb.To)
+}
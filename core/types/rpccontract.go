@@ -6,7 +6,14 @@ type RpcContract struct {
 	Abbr        string               `json:"abbr"`
 	Increase    bool                 `json:"increase"`
 	Description string               `json:"description"`
+	Issuer      string               `json:"issuer"`
+	MetadataURI string               `json:"metadatauri"`
 	Records     []*RPcContractRecord `json:"records"`
+	Burned      float64              `json:"burned"`
+	Policies    []string             `json:"policies"`
+	Paused      bool                 `json:"paused"`
+	Frozen      []string             `json:"frozen"`
+	Allowlist   []string             `json:"allowlist"`
 }
 
 type RPcContractRecord struct {
@@ -15,6 +22,10 @@ type RPcContractRecord struct {
 	Time     uint64  `json:"time"`
 	Amount   float64 `json:"amount"`
 	Receiver string  `json:"receiver"`
+	Action   string  `json:"action"`
+	// Description and metadata uri set by a metadata update
+	Description string `json:"description,omitempty"`
+	MetadataURI string `json:"metadatauri,omitempty"`
 }
 
 func TranslateContractToRpcContract(contract *Contract) *RpcContract {
@@ -24,7 +35,14 @@ func TranslateContractToRpcContract(contract *Contract) *RpcContract {
 		Abbr:        contract.CoinAbbr,
 		Increase:    contract.IncreaseSwitch,
 		Description: contract.Description,
+		Issuer:      contract.Issuer,
+		MetadataURI: contract.MetadataURI,
 		Records:     make([]*RPcContractRecord, contract.Records.Len()),
+		Burned:      Amount(contract.Burned).ToCoin(),
+		Policies:    PolicyNames(contract.Policy),
+		Paused:      contract.Paused,
+		Frozen:      append([]string{}, contract.Frozen...),
+		Allowlist:   append([]string{}, contract.Allowlist...),
 	}
 	for i, record := range *contract.Records {
 		rpcContract.Records[i] = &RPcContractRecord{
@@ -33,6 +51,10 @@ func TranslateContractToRpcContract(contract *Contract) *RpcContract {
 			Time:     record.Time,
 			Amount:   Amount(record.Amount).ToCoin(),
 			Receiver: record.Receiver,
+			Action:   record.GetAction(),
+
+			Description: record.Description,
+			MetadataURI: record.MetadataURI,
 		}
 	}
 	return rpcContract
@@ -11,6 +11,8 @@ type RpcHeader struct {
 	StateRoot     string    `json:"stateroot"`
 	ContractRoot  string    `json:"contractroot"`
 	ConsensusRoot string    `json:"consensusroot"`
+	HTLCRoot      string    `json:"htlcroot"`
+	NameRoot      string    `json:"nameroot"`
 	Height        uint64    `json:"height"`
 	Time          time.Time `json:"time"`
 	Term          uint64    `json:"term"`
@@ -26,6 +28,8 @@ func TranslateHeaderToRpcHeader(header *Header) *RpcHeader {
 		StateRoot:     header.StateRoot.String(),
 		ContractRoot:  header.ContractRoot.String(),
 		ConsensusRoot: header.ConsensusRoot.String(),
+		HTLCRoot:      header.HTLCRoot.String(),
+		NameRoot:      header.NameRoot.String(),
 		Height:        header.Height,
 		Time:          time.Unix(int64(header.Time), 0),
 		Term:          header.Term,
@@ -0,0 +1,38 @@
+package types
+
+import "encoding/hex"
+
+type RpcHTLC struct {
+	Id           string  `json:"id"`
+	From         string  `json:"from"`
+	To           string  `json:"to"`
+	Contract     string  `json:"contract"`
+	Amount       float64 `json:"amount"`
+	HashLock     string  `json:"hashlock"`
+	ExpireHeight uint64  `json:"expireheight"`
+	Height       uint64  `json:"height"`
+	Status       string  `json:"status"`
+	Preimage     string  `json:"preimage,omitempty"`
+	SettleTx     string  `json:"settletx,omitempty"`
+	SettleHeight uint64  `json:"settleheight,omitempty"`
+}
+
+func TranslateHTLCToRpcHTLC(htlc *HTLC) *RpcHTLC {
+	rpcHTLC := &RpcHTLC{
+		Id:           htlc.Id.String(),
+		From:         htlc.From.String(),
+		To:           htlc.To.String(),
+		Contract:     htlc.Contract.String(),
+		Amount:       Amount(htlc.Amount).ToCoin(),
+		HashLock:     htlc.HashLock.String(),
+		ExpireHeight: htlc.ExpireHeight,
+		Height:       htlc.Height,
+		Status:       htlc.Status,
+		Preimage:     hex.EncodeToString(htlc.Preimage),
+	}
+	if htlc.Status != HTLCLocked {
+		rpcHTLC.SettleTx = htlc.SettleTx.String()
+		rpcHTLC.SettleHeight = htlc.SettleHeight
+	}
+	return rpcHTLC
+}
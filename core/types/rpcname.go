@@ -0,0 +1,17 @@
+package types
+
+type RpcName struct {
+	Name         string `json:"name"`
+	Owner        string `json:"owner"`
+	Height       uint64 `json:"height"`
+	ExpireHeight uint64 `json:"expireheight"`
+}
+
+func TranslateNameToRpcName(name *Name) *RpcName {
+	return &RpcName{
+		Name:         name.Name,
+		Owner:        name.Owner.String(),
+		Height:       name.Height,
+		ExpireHeight: name.ExpireHeight,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/uworldao/UWORLD/common/hasharry"
 )
 
@@ -19,6 +20,20 @@ type RpcTransactionHead struct {
 	Time       uint64          `json:"time"`
 	Note       string          `json:"note"`
 	SignScript *RpcSignScript  `json:"signscript"`
+
+	Payer           string         `json:"payer,omitempty"`
+	PayerSignScript *RpcSignScript `json:"payersignscript,omitempty"`
+
+	Memo string `json:"memo,omitempty"`
+
+	ValidUntil uint64 `json:"validuntil,omitempty"`
+
+	PoW *RpcTxPoW `json:"pow,omitempty"`
+}
+
+type RpcTxPoW struct {
+	Nonce uint64   `json:"nonce"`
+	Cycle []uint32 `json:"cycle"`
 }
 
 type RpcTransaction struct {
@@ -79,6 +94,186 @@ func TranslateRpcTxToTx(rpcTx *RpcTransaction) (*Transaction, error) {
 			return nil, err
 		}
 		txBody, err = translateRpcContractBodyToBody(body)
+	case TimeLockTransaction:
+		body := &RpcTimeLockTransactionBody{}
+		bytes, err := json.Marshal(rpcTx.TxBody)
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(bytes, body)
+		if err != nil {
+			return nil, err
+		}
+		txBody, err = translateRpcTimeLockBodyToBody(body)
+	case BurnTransaction:
+		body := &RpcBurnBody{}
+		bytes, err := json.Marshal(rpcTx.TxBody)
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(bytes, body)
+		if err != nil {
+			return nil, err
+		}
+		txBody = &BurnBody{
+			Contract: hasharry.StringToAddress(body.Contract),
+			Amount:   body.Amount,
+		}
+	case ApproveTransaction:
+		body := &RpcApproveBody{}
+		bytes, err := json.Marshal(rpcTx.TxBody)
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(bytes, body)
+		if err != nil {
+			return nil, err
+		}
+		txBody = &ApproveBody{
+			Contract:  hasharry.StringToAddress(body.Contract),
+			Spender:   hasharry.StringToAddress(body.Spender),
+			Allowance: body.Allowance,
+		}
+	case TransferFromTransaction:
+		body := &RpcTransferFromBody{}
+		bytes, err := json.Marshal(rpcTx.TxBody)
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(bytes, body)
+		if err != nil {
+			return nil, err
+		}
+		txBody = &TransferFromBody{
+			Contract: hasharry.StringToAddress(body.Contract),
+			Owner:    hasharry.StringToAddress(body.Owner),
+			To:       hasharry.StringToAddress(body.To),
+			Amount:   body.Amount,
+		}
+	case ContractPolicyTransaction:
+		body := &RpcContractPolicyBody{}
+		bytes, err := json.Marshal(rpcTx.TxBody)
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(bytes, body)
+		if err != nil {
+			return nil, err
+		}
+		action, ok := ParsePolicyAction(body.Action)
+		if !ok {
+			return nil, errors.New("unknown policy action")
+		}
+		txBody = &ContractPolicyBody{
+			Contract: hasharry.StringToAddress(body.Contract),
+			Action:   action,
+			Holder:   hasharry.StringToAddress(body.Holder),
+		}
+	case ContractMetadataTransaction:
+		body := &RpcContractMetadataBody{}
+		bytes, err := json.Marshal(rpcTx.TxBody)
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(bytes, body)
+		if err != nil {
+			return nil, err
+		}
+		txBody = &ContractMetadataBody{
+			Contract:    hasharry.StringToAddress(body.Contract),
+			Description: body.Description,
+			MetadataURI: body.MetadataURI,
+		}
+	case ContractIssuerTransaction:
+		body := &RpcContractIssuerBody{}
+		bytes, err := json.Marshal(rpcTx.TxBody)
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(bytes, body)
+		if err != nil {
+			return nil, err
+		}
+		txBody = &ContractIssuerBody{
+			Contract: hasharry.StringToAddress(body.Contract),
+			Issuer:   hasharry.StringToAddress(body.Issuer),
+		}
+	case NameRegisterTransaction, NameRenewTransaction, NameTransferTransaction:
+		body := &RpcNameBody{}
+		bytes, err := json.Marshal(rpcTx.TxBody)
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(bytes, body)
+		if err != nil {
+			return nil, err
+		}
+		txBody = translateRpcNameBodyToBody(rpcTx.TxHead.TxType, body)
+	case AnchorTransaction:
+		body := &RpcAnchorBody{}
+		bytes, err := json.Marshal(rpcTx.TxBody)
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(bytes, body)
+		if err != nil {
+			return nil, err
+		}
+		if txBody, err = translateRpcAnchorBodyToBody(body); err != nil {
+			return nil, err
+		}
+	case KeyRotationTransaction:
+		body := &RpcKeyRotationBody{}
+		bytes, err := json.Marshal(rpcTx.TxBody)
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(bytes, body)
+		if err != nil {
+			return nil, err
+		}
+		if txBody, err = translateRpcKeyRotationBodyToBody(body); err != nil {
+			return nil, err
+		}
+	case HTLCLockTransaction:
+		body := &RpcHTLCLockBody{}
+		bytes, err := json.Marshal(rpcTx.TxBody)
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(bytes, body)
+		if err != nil {
+			return nil, err
+		}
+		if txBody, err = translateRpcHTLCLockBodyToBody(body); err != nil {
+			return nil, err
+		}
+	case HTLCClaimTransaction:
+		body := &RpcHTLCClaimBody{}
+		bytes, err := json.Marshal(rpcTx.TxBody)
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(bytes, body)
+		if err != nil {
+			return nil, err
+		}
+		if txBody, err = translateRpcHTLCClaimBodyToBody(body); err != nil {
+			return nil, err
+		}
+	case HTLCRefundTransaction:
+		body := &RpcHTLCRefundBody{}
+		bytes, err := json.Marshal(rpcTx.TxBody)
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(bytes, body)
+		if err != nil {
+			return nil, err
+		}
+		if txBody, err = translateRpcHTLCRefundBodyToBody(body); err != nil {
+			return nil, err
+		}
 		/*case types.LoginCandidate:
 			txBody, err = translateRpcLoginBodyToBody(rpcTx.LoginBody)
 		case types.LogoutCandidate:
@@ -86,6 +281,22 @@ func TranslateRpcTxToTx(rpcTx *RpcTransaction) (*Transaction, error) {
 		case types.VoteToCandidate:
 			txBody, err = translateRpcVoteBodyToBody(rpcTx.VoteBody)*/
 	}
+	var payerSignScript *SignScript
+	if rpcTx.TxHead.PayerSignScript != nil {
+		if payerSignScript, err = TranslateRpcSignScriptToSignScript(rpcTx.TxHead.PayerSignScript); err != nil {
+			return nil, err
+		}
+	}
+	var payer hasharry.Address
+	if rpcTx.TxHead.Payer != "" {
+		payer = hasharry.StringToAddress(rpcTx.TxHead.Payer)
+	}
+	var memo []byte
+	if rpcTx.TxHead.Memo != "" {
+		if memo, err = hex.DecodeString(rpcTx.TxHead.Memo); err != nil {
+			return nil, errors.New("wrong memo")
+		}
+	}
 	tx := &Transaction{
 		TxHead: &TransactionHead{
 			TxHash:     txHash,
@@ -96,9 +307,19 @@ func TranslateRpcTxToTx(rpcTx *RpcTransaction) (*Transaction, error) {
 			Time:       rpcTx.TxHead.Time,
 			Note:       rpcTx.TxHead.Note,
 			SignScript: signScript,
+
+			Payer:           payer,
+			PayerSignScript: payerSignScript,
+
+			Memo: memo,
+
+			ValidUntil: rpcTx.TxHead.ValidUntil,
 		},
 		TxBody: txBody,
 	}
+	if pow := rpcTx.TxHead.PoW; pow != nil {
+		tx.TxHead.PoW = &TxPoW{Nonce: pow.Nonce, Cycle: pow.Cycle}
+	}
 	return tx, nil
 }
 
@@ -118,6 +339,22 @@ func TranslateTxToRpcTx(tx *Transaction) (*RpcTransaction, error) {
 			}},
 		TxBody: nil,
 	}
+	if tx.IsSponsored() {
+		rpcTx.TxHead.Payer = tx.GetPayer().String()
+		if payerSignScript := tx.TxHead.PayerSignScript; payerSignScript != nil && len(payerSignScript.Signature) != 0 {
+			rpcTx.TxHead.PayerSignScript = &RpcSignScript{
+				Signature: hex.EncodeToString(payerSignScript.Signature),
+				PubKey:    hex.EncodeToString(payerSignScript.PubKey),
+			}
+		}
+	}
+	if len(tx.TxHead.Memo) != 0 {
+		rpcTx.TxHead.Memo = hex.EncodeToString(tx.TxHead.Memo)
+	}
+	rpcTx.TxHead.ValidUntil = tx.TxHead.ValidUntil
+	if pow := tx.TxHead.PoW; pow != nil {
+		rpcTx.TxHead.PoW = &RpcTxPoW{Nonce: pow.Nonce, Cycle: pow.Cycle}
+	}
 	switch tx.GetTxType() {
 	case NormalTransaction:
 		rpcTx.TxBody = &RpcNormalTransactionBody{
@@ -134,6 +371,99 @@ func TranslateTxToRpcTx(tx *Transaction) (*RpcTransaction, error) {
 			Description: tx.GetTxBody().GetDescription(),
 			Increase:    tx.GetTxBody().GetIncreaseSwitch(),
 			Amount:      tx.GetTxBody().GetAmount(),
+			Policies:    PolicyNames(tx.GetTxBody().(*ContractBody).Policy),
+		}
+	case TimeLockTransaction:
+		body := tx.GetTxBody().(*TimeLockTransactionBody)
+		rpcTx.TxBody = &RpcTimeLockTransactionBody{
+			Contract:     body.Contract.String(),
+			To:           body.To.String(),
+			Amount:       body.Amount,
+			UnlockHeight: body.UnlockHeight,
+			UnlockTime:   body.UnlockTime,
+		}
+	case BurnTransaction:
+		rpcTx.TxBody = &RpcBurnBody{
+			Contract: tx.GetTxBody().GetContract().String(),
+			Amount:   tx.GetTxBody().GetAmount(),
+		}
+	case ApproveTransaction:
+		body := tx.GetTxBody().(*ApproveBody)
+		rpcTx.TxBody = &RpcApproveBody{
+			Contract:  body.Contract.String(),
+			Spender:   body.Spender.String(),
+			Allowance: body.Allowance,
+		}
+	case TransferFromTransaction:
+		body := tx.GetTxBody().(*TransferFromBody)
+		rpcTx.TxBody = &RpcTransferFromBody{
+			Contract: body.Contract.String(),
+			Owner:    body.Owner.String(),
+			To:       body.To.String(),
+			Amount:   body.Amount,
+		}
+	case ContractPolicyTransaction:
+		body := tx.GetTxBody().(*ContractPolicyBody)
+		rpcTx.TxBody = &RpcContractPolicyBody{
+			Contract: body.Contract.String(),
+			Action:   body.Action.String(),
+			Holder:   body.Holder.String(),
+		}
+	case ContractMetadataTransaction:
+		body := tx.GetTxBody().(*ContractMetadataBody)
+		rpcTx.TxBody = &RpcContractMetadataBody{
+			Contract:    body.Contract.String(),
+			Description: body.Description,
+			MetadataURI: body.MetadataURI,
+		}
+	case ContractIssuerTransaction:
+		body := tx.GetTxBody().(*ContractIssuerBody)
+		rpcTx.TxBody = &RpcContractIssuerBody{
+			Contract: body.Contract.String(),
+			Issuer:   body.Issuer.String(),
+		}
+	case NameRegisterTransaction, NameRenewTransaction:
+		rpcTx.TxBody = &RpcNameBody{
+			Name: tx.GetTxBody().GetName(),
+		}
+	case NameTransferTransaction:
+		rpcTx.TxBody = &RpcNameBody{
+			Name: tx.GetTxBody().GetName(),
+			To:   tx.GetTxBody().ToAddress().String(),
+		}
+	case AnchorTransaction:
+		body := tx.GetTxBody().(*AnchorBody)
+		digests := make([]string, len(body.Digests))
+		for i, digest := range body.Digests {
+			digests[i] = digest.String()
+		}
+		rpcTx.TxBody = &RpcAnchorBody{
+			Digests: digests,
+		}
+	case KeyRotationTransaction:
+		body := tx.GetTxBody().(*KeyRotationBody)
+		rpcTx.TxBody = &RpcKeyRotationBody{
+			PubKey: hex.EncodeToString(body.PubKey),
+		}
+	case HTLCLockTransaction:
+		body := tx.GetTxBody().(*HTLCLockBody)
+		rpcTx.TxBody = &RpcHTLCLockBody{
+			Contract:     body.Contract.String(),
+			To:           body.To.String(),
+			Amount:       body.Amount,
+			HashLock:     body.HashLock.String(),
+			ExpireHeight: body.ExpireHeight,
+		}
+	case HTLCClaimTransaction:
+		body := tx.GetTxBody().(*HTLCClaimBody)
+		rpcTx.TxBody = &RpcHTLCClaimBody{
+			Id:       body.Id.String(),
+			Preimage: hex.EncodeToString(body.Preimage),
+		}
+	case HTLCRefundTransaction:
+		body := tx.GetTxBody().(*HTLCRefundBody)
+		rpcTx.TxBody = &RpcHTLCRefundBody{
+			Id: body.Id.String(),
 		}
 	case LoginCandidate:
 		rpcTx.TxBody = &RpcLoginTransactionBody{
@@ -182,10 +512,89 @@ func translateRpcNormalBodyToBody(rpcBody *RpcNormalTransactionBody) (*NormalTra
 	}, nil
 }
 
+func translateRpcTimeLockBodyToBody(rpcBody *RpcTimeLockTransactionBody) (*TimeLockTransactionBody, error) {
+	if rpcBody == nil {
+		return nil, errors.New("wrong time lock transaction body")
+	}
+
+	return &TimeLockTransactionBody{
+		Contract:     hasharry.StringToAddress(rpcBody.Contract),
+		To:           hasharry.StringToAddress(rpcBody.To),
+		Amount:       rpcBody.Amount,
+		UnlockHeight: rpcBody.UnlockHeight,
+		UnlockTime:   rpcBody.UnlockTime,
+	}, nil
+}
+
+func translateRpcAnchorBodyToBody(rpcBody *RpcAnchorBody) (*AnchorBody, error) {
+	digests := make([]hasharry.Hash, len(rpcBody.Digests))
+	for i, s := range rpcBody.Digests {
+		digest, err := ParseDigest(s)
+		if err != nil {
+			return nil, err
+		}
+		digests[i] = digest
+	}
+	return &AnchorBody{Digests: digests}, nil
+}
+
+func translateRpcKeyRotationBodyToBody(rpcBody *RpcKeyRotationBody) (*KeyRotationBody, error) {
+	pubKey, err := hex.DecodeString(rpcBody.PubKey)
+	if err != nil {
+		return nil, errors.New("wrong public key")
+	}
+	return &KeyRotationBody{PubKey: pubKey}, nil
+}
+
+func translateRpcHTLCLockBodyToBody(rpcBody *RpcHTLCLockBody) (*HTLCLockBody, error) {
+	hashLock, err := hasharry.StringToHash(rpcBody.HashLock)
+	if err != nil {
+		return nil, errors.New("wrong hash lock")
+	}
+	return &HTLCLockBody{
+		Contract:     hasharry.StringToAddress(rpcBody.Contract),
+		To:           hasharry.StringToAddress(rpcBody.To),
+		Amount:       rpcBody.Amount,
+		HashLock:     hashLock,
+		ExpireHeight: rpcBody.ExpireHeight,
+	}, nil
+}
+
+func translateRpcHTLCClaimBodyToBody(rpcBody *RpcHTLCClaimBody) (*HTLCClaimBody, error) {
+	id, err := hasharry.StringToHash(rpcBody.Id)
+	if err != nil {
+		return nil, errors.New("wrong htlc id")
+	}
+	preimage, err := hex.DecodeString(rpcBody.Preimage)
+	if err != nil {
+		return nil, errors.New("wrong preimage")
+	}
+	return &HTLCClaimBody{
+		Id:       id,
+		Preimage: preimage,
+	}, nil
+}
+
+func translateRpcHTLCRefundBodyToBody(rpcBody *RpcHTLCRefundBody) (*HTLCRefundBody, error) {
+	id, err := hasharry.StringToHash(rpcBody.Id)
+	if err != nil {
+		return nil, errors.New("wrong htlc id")
+	}
+	return &HTLCRefundBody{Id: id}, nil
+}
+
 func translateRpcContractBodyToBody(rpcBody *RpcContractTransactionBody) (*ContractBody, error) {
 	if rpcBody == nil {
 		return nil, errors.New("wrong contract transaction body")
 	}
+	var policy uint8
+	for _, name := range rpcBody.Policies {
+		p, ok := ParsePolicy(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown policy %s", name)
+		}
+		policy |= p
+	}
 
 	return &ContractBody{
 		Contract:       hasharry.StringToAddress(rpcBody.Contract),
@@ -195,9 +604,21 @@ func translateRpcContractBodyToBody(rpcBody *RpcContractTransactionBody) (*Contr
 		Name:           rpcBody.Name,
 		Description:    rpcBody.Description,
 		Amount:         rpcBody.Amount,
+		Policy:         policy,
 	}, nil
 }
 
+func translateRpcNameBodyToBody(txType TransactionType, rpcBody *RpcNameBody) ITransactionBody {
+	switch txType {
+	case NameRegisterTransaction:
+		return &NameRegisterBody{Name: rpcBody.Name}
+	case NameRenewTransaction:
+		return &NameRenewBody{Name: rpcBody.Name}
+	default:
+		return &NameTransferBody{Name: rpcBody.Name, To: hasharry.StringToAddress(rpcBody.To)}
+	}
+}
+
 func translateRpcLoginBodyToBody(rpcBody *RpcLoginTransactionBody) (*LoginTransactionBody, error) {
 	if rpcBody == nil {
 		return nil, errors.New("wrong transaction body")
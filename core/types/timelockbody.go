@@ -0,0 +1,63 @@
+package types
+
+import (
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/param"
+	"github.com/uworldao/UWORLD/ut"
+)
+
+// Time-locked transfer transaction body. The amount received is locked
+// until the confirmed block height reaches UnlockHeight and the confirmed
+// block time reaches UnlockTime, a zero value means no such condition.
+type TimeLockTransactionBody struct {
+	Contract     hasharry.Address
+	To           hasharry.Address
+	Amount       uint64
+	UnlockHeight uint64
+	UnlockTime   uint64
+}
+
+func (tb *TimeLockTransactionBody) ToAddress() hasharry.Address {
+	return tb.To
+}
+
+func (tb *TimeLockTransactionBody) GetAmount() uint64 {
+	return tb.Amount
+}
+
+func (tb *TimeLockTransactionBody) GetContract() hasharry.Address {
+	return tb.Contract
+}
+
+func (tb *TimeLockTransactionBody) GetName() string {
+	return ""
+}
+
+func (tb *TimeLockTransactionBody) GetAbbr() string {
+	return ""
+}
+
+func (tb *TimeLockTransactionBody) GetIncreaseSwitch() bool {
+	return false
+}
+
+func (tb *TimeLockTransactionBody) GetDescription() string {
+	return ""
+}
+
+func (tb *TimeLockTransactionBody) GetPeerId() []byte {
+	return nil
+}
+
+func (tb *TimeLockTransactionBody) VerifyBody(from hasharry.Address) error {
+	if !ut.IsValidContractAddress(param.Net, tb.Contract.String()) {
+		return ErrContractAddr
+	}
+	if !ut.CheckUWDAddress(param.Net, tb.To.String()) {
+		return ErrAddress
+	}
+	if tb.UnlockHeight == 0 && tb.UnlockTime == 0 {
+		return ErrUnlockCondition
+	}
+	return nil
+}
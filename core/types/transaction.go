@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/uworldao/UWORLD/common/encode/rlp"
 	hash2 "github.com/uworldao/UWORLD/common/hasharry"
@@ -18,6 +19,21 @@ const (
 	LoginCandidate
 	/*LogoutCandidate
 	VoteToCandidate*/
+	TimeLockTransaction
+	HTLCLockTransaction
+	HTLCClaimTransaction
+	HTLCRefundTransaction
+	BurnTransaction
+	ApproveTransaction
+	TransferFromTransaction
+	ContractPolicyTransaction
+	ContractMetadataTransaction
+	ContractIssuerTransaction
+	NameRegisterTransaction
+	NameRenewTransaction
+	NameTransferTransaction
+	AnchorTransaction
+	KeyRotationTransaction
 )
 const MaxNote = 256
 
@@ -34,6 +50,23 @@ type TransactionHead struct {
 	Time       uint64
 	Note       string
 	SignScript *SignScript
+
+	// The account that pays the fees instead of the sender, both
+	// the sender and the payer sign the transaction hash.
+	Payer           hash2.Address `rlp:"optional"`
+	PayerSignScript *SignScript   `rlp:"nil,optional"`
+
+	// The note encrypted with the public key of the receiver,
+	// only the receiver can read it.
+	Memo []byte `rlp:"optional"`
+
+	// The last block height that can include the transaction,
+	// 0 if the transaction does not expire.
+	ValidUntil uint64 `rlp:"optional"`
+
+	// The proof of work over the transaction hash that replaces the
+	// fees, it is not part of the hash like the signatures.
+	PoW *TxPoW `rlp:"nil,optional"`
 }
 
 type Transaction struct {
@@ -61,7 +94,31 @@ func (t *Transaction) VerifyTx() error {
 	return nil
 }
 
+// Verify everything except the signatures, for transactions that
+// are built to be signed later
+func (t *Transaction) VerifyUnsignedTx() error {
+	if err := t.verifyUnsignedHead(); err != nil {
+		return err
+	}
+
+	if err := t.verifyBody(); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (t *Transaction) verifyHead() error {
+	if err := t.verifyUnsignedHead(); err != nil {
+		return err
+	}
+
+	if err := t.verifyTxSinger(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *Transaction) verifyUnsignedHead() error {
 	if t.TxHead == nil {
 		return ErrTxHead
 	}
@@ -82,11 +139,11 @@ func (t *Transaction) verifyHead() error {
 		return err
 	}
 
-	if err := t.verifyTxFees(); err != nil {
+	if err := t.verifyTxPoW(); err != nil {
 		return err
 	}
 
-	if err := t.verifyTxSinger(); err != nil {
+	if err := t.verifyTxFees(); err != nil {
 		return err
 	}
 	return nil
@@ -118,27 +175,57 @@ func (t *Transaction) VerifyCoinBaseTx(height, sumFees uint64) error {
 	return nil
 }
 
+// The fee of the transaction can not be lower than the minimum. The minimum
+// required by the current block is verified by the block chain and tx pool.
+// A transaction with proof of work can pay lower fees than the normal fees.
 func (t *Transaction) verifyTxFees() error {
-	var fees uint64
 	switch t.TxHead.TxType {
-	case NormalTransaction:
-		fees = param.Fees
+	case NormalTransaction, TimeLockTransaction, HTLCLockTransaction, HTLCClaimTransaction, HTLCRefundTransaction, BurnTransaction,
+		ApproveTransaction, TransferFromTransaction, ContractPolicyTransaction, ContractMetadataTransaction, ContractIssuerTransaction,
+		NameTransferTransaction, AnchorTransaction, KeyRotationTransaction:
+		if t.TxHead.Fees < param.Fees && !t.HasPoW() {
+			return fmt.Errorf("transaction costs at least %d fees", param.Fees)
+		}
+		if t.TxHead.Fees > param.MaxFees {
+			return fmt.Errorf("transaction fees cannot exceed %d", param.MaxFees)
+		}
 	case ContractTransaction:
-		fees = param.TokenConsumption
-	}
-	if t.TxHead.Fees != fees {
-		return fmt.Errorf("transaction costs %d fees", fees)
+		if t.TxHead.Fees < param.TokenConsumption {
+			return fmt.Errorf("transaction costs at least %d fees", param.TokenConsumption)
+		}
+	case NameRegisterTransaction, NameRenewTransaction:
+		if t.TxHead.Fees < param.NameConsumption {
+			return fmt.Errorf("transaction costs at least %d fees", param.NameConsumption)
+		}
 	}
 	return nil
 }
 
+// Verify the signatures of the transaction. Whether the keys control
+// the sender and the payer depends on the keys bound in the account
+// state, which is verified by Account.VerifySigner.
 func (t *Transaction) verifyTxSinger() error {
 	if !Verify(t.TxHead.TxHash, t.TxHead.SignScript) {
 		return ErrSignature
 	}
 
-	if !VerifySigner(param.Net, t.TxHead.From, t.TxHead.SignScript.PubKey) {
-		return ErrSigner
+	if t.IsSponsored() {
+		return t.verifyTxPayer()
+	}
+	return nil
+}
+
+// The payer of a sponsored transaction must be another account
+// and must sign the transaction hash as well.
+func (t *Transaction) verifyTxPayer() error {
+	if t.TxHead.Payer.IsEqual(t.TxHead.From) {
+		return errors.New("the payer cannot be the sender")
+	}
+	if !ut.CheckUWDAddress(param.Net, t.TxHead.Payer.String()) {
+		return ErrAddress
+	}
+	if t.TxHead.PayerSignScript == nil || !Verify(t.TxHead.TxHash, t.TxHead.PayerSignScript) {
+		return ErrPayerSignature
 	}
 	return nil
 }
@@ -148,6 +235,8 @@ func (t *Transaction) verifyTxSize() error {
 	switch t.TxHead.TxType {
 	case NormalTransaction:
 		fallthrough
+	case TimeLockTransaction:
+		fallthrough
 	case ContractTransaction:
 		return nil
 		/*case LogoutCandidate:
@@ -172,10 +261,21 @@ func (t *Transaction) verifyCoinBaseAmount(height, amount uint64) error {
 }
 
 func (t *Transaction) verifyAmount() error {
-	nTx, ok := t.TxBody.(*NormalTransactionBody)
-	if ok && nTx.Amount < param.MinAllowedAmount {
+	switch t.TxHead.TxType {
+	case NormalTransaction, TimeLockTransaction, HTLCLockTransaction, BurnTransaction, TransferFromTransaction:
+	default:
+		return nil
+	}
+	amount := t.TxBody.GetAmount()
+	if amount < param.MinAllowedAmount {
 		return fmt.Errorf("the minimum amount of the transaction must not be less than %d", param.MinAllowedAmount)
 	}
+	// The fees of the token transaction are deducted from the amount,
+	// except that the spender of a transfer-from pays the fees.
+	if !t.IsSponsored() && t.TxHead.TxType != TransferFromTransaction &&
+		t.TxBody.GetContract().IsEqual(param.Token) && amount <= t.TxHead.Fees {
+		return errors.New("the amount of the transaction must be greater than the fees")
+	}
 	return nil
 }
 
@@ -192,6 +292,22 @@ func (t *Transaction) verifyTxType() error {
 		return nil
 	case ContractTransaction:
 		return nil
+	case TimeLockTransaction:
+		return nil
+	case HTLCLockTransaction, HTLCClaimTransaction, HTLCRefundTransaction:
+		return nil
+	case BurnTransaction:
+		return nil
+	case ApproveTransaction, TransferFromTransaction:
+		return nil
+	case ContractPolicyTransaction, ContractMetadataTransaction, ContractIssuerTransaction:
+		return nil
+	case NameRegisterTransaction, NameRenewTransaction, NameTransferTransaction:
+		return nil
+	case AnchorTransaction:
+		return nil
+	case KeyRotationTransaction:
+		return nil
 		/*case VoteToCandidate:
 			return nil
 		case LoginCandidate:
@@ -215,6 +331,9 @@ func (t *Transaction) verifyTxNote() error {
 	if len(t.TxHead.Note) > MaxNote {
 		return fmt.Errorf("the length of the transaction note must not be greater than %d", MaxNote)
 	}
+	if len(t.TxHead.Memo) > MaxMemo {
+		return fmt.Errorf("the length of the transaction memo must not be greater than %d", MaxMemo)
+	}
 	return nil
 }
 
@@ -230,9 +349,20 @@ func (t *Transaction) SignTx(key *secp256k1.PrivateKey) error {
 	return nil
 }
 
+// Sign the transaction as the fee payer
+func (t *Transaction) SignPayer(key *secp256k1.PrivateKey) error {
+	var err error
+	if t.TxHead.PayerSignScript, err = Sign(key, t.TxHead.TxHash); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (t *Transaction) SetHash() error {
 	t.TxHead.TxHash = hash2.Hash{}
 	t.TxHead.SignScript = &SignScript{}
+	t.TxHead.PayerSignScript = nil
+	t.TxHead.PoW = nil
 	rpcTx, err := TranslateTxToRpcTx(t)
 	if err != nil {
 		return err
@@ -255,6 +385,15 @@ func (t *Transaction) copy() *Transaction {
 		Time:       t.TxHead.Time,
 		Note:       t.TxHead.Note,
 		SignScript: t.TxHead.SignScript,
+
+		Payer:           t.TxHead.Payer,
+		PayerSignScript: t.TxHead.PayerSignScript,
+
+		Memo: t.TxHead.Memo,
+
+		ValidUntil: t.TxHead.ValidUntil,
+
+		PoW: t.TxHead.PoW,
 	}
 	return &Transaction{
 		TxHead: header,
@@ -262,6 +401,12 @@ func (t *Transaction) copy() *Transaction {
 	}
 }
 
+// Whether the transaction can no longer be included in the block
+// of the height
+func (t *Transaction) IsExpired(height uint64) bool {
+	return t.TxHead.ValidUntil != 0 && height > t.TxHead.ValidUntil
+}
+
 func (t *Transaction) NonceKey() string {
 	return t.TxHead.From.String() + "_" + strconv.FormatUint(t.TxHead.Nonce, 10)
 }
@@ -278,6 +423,15 @@ func (t *Transaction) GetFees() uint64 {
 	return t.TxHead.Fees
 }
 
+// Whether the fees are paid by an account other than the sender
+func (t *Transaction) IsSponsored() bool {
+	return !hash2.EmptyAddress(t.TxHead.Payer)
+}
+
+func (t *Transaction) GetPayer() hash2.Address {
+	return t.TxHead.Payer
+}
+
 func (t *Transaction) GetNonce() uint64 {
 	return t.TxHead.Nonce
 }
@@ -323,6 +477,20 @@ func (t *TxLocation) GetHeight() uint64 {
 	return t.Height
 }
 
+// Calculate the total amount of UWD issued up to the height, which
+// includes the genesis coins and the coin base rewards
+func CalIssued(height uint64) uint64 {
+	var sum uint64
+	for _, info := range param.MappingCoin {
+		sum += info.Amount
+	}
+	day := 60 * 60 * 24 / param.BlockInterval
+	for h := param.CoinHeight + day - 1; h <= height; h += day {
+		sum += CalCoinBase(h, param.CoinHeight)
+	}
+	return sum
+}
+
 func CalCoinBase(height, startHeight uint64) uint64 {
 	if height < startHeight {
 		return 0
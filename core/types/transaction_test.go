@@ -2,6 +2,11 @@ package types
 
 import (
 	"fmt"
+	"github.com/uworldao/UWORLD/common/encode/rlp"
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/crypto/ecc/secp256k1"
+	"github.com/uworldao/UWORLD/param"
+	"github.com/uworldao/UWORLD/ut"
 	"testing"
 )
 
@@ -17,3 +22,142 @@ func TestCalCoinBase(t *testing.T) {
 	}
 	fmt.Println(sum)
 }
+
+func TestTransaction_Sponsored(t *testing.T) {
+	fromKey, _ := secp256k1.GeneratePrivateKey()
+	payerKey, _ := secp256k1.GeneratePrivateKey()
+	from := hasharry.StringToAddress(ut.GenerateUWDAddress(param.Net, fromKey.PubKey()))
+	payer := hasharry.StringToAddress(ut.GenerateUWDAddress(param.Net, payerKey.PubKey()))
+
+	tx := newTestTx(from, payer, 1, param.AtomsPerCoin)
+	tx.TxHead.Payer = payer
+	tx.SetHash()
+	if err := tx.SignTx(fromKey); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.VerifyTx(); err != ErrPayerSignature {
+		t.Fatalf("expected ErrPayerSignature, got %v", err)
+	}
+	if err := tx.SignPayer(payerKey); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.VerifyTx(); err != nil {
+		t.Fatal(err)
+	}
+
+	bytes, _ := rlp.EncodeToBytes(tx.TranslateToRlpTransaction())
+	var rlpTx *RlpTransaction
+	if err := rlp.DecodeBytes(bytes, &rlpTx); err != nil {
+		t.Fatal(err)
+	}
+	if err := rlpTx.TranslateToTransaction().VerifyTx(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTransaction_UnsponsoredEncoding(t *testing.T) {
+	key, _ := secp256k1.GeneratePrivateKey()
+	from := hasharry.StringToAddress(ut.GenerateUWDAddress(param.Net, key.PubKey()))
+	tx := newTestTx(from, from, 1, param.AtomsPerCoin)
+	tx.SetHash()
+	tx.SignTx(key)
+
+	// The encoding of a transaction without payer is the same as
+	// the encoding of the head before the payer was added
+	type oldHead struct {
+		TxHash     hasharry.Hash
+		TxType     TransactionType
+		From       hasharry.Address
+		Nonce      uint64
+		Fees       uint64
+		Time       uint64
+		Note       string
+		SignScript *SignScript
+	}
+	head := tx.TxHead
+	oldBytes, _ := rlp.EncodeToBytes(&oldHead{head.TxHash, head.TxType, head.From, head.Nonce,
+		head.Fees, head.Time, head.Note, head.SignScript})
+	newBytes, _ := rlp.EncodeToBytes(head)
+	if string(oldBytes) != string(newBytes) {
+		t.Fatal("the encoding of the transaction head changed")
+	}
+	if err := tx.VerifyTx(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCalIssued(t *testing.T) {
+	day := 60 * 60 * 24 / param.BlockInterval
+	genesis := param.MappingCoin[0].Amount
+	if issued := CalIssued(day - 1); issued != genesis {
+		t.Fatalf("wrong issued %d before the first reward", issued)
+	}
+	reward := CalCoinBase(day, param.CoinHeight)
+	if reward == 0 {
+		t.Fatal("the first reward should not be 0")
+	}
+	if issued := CalIssued(day); issued != genesis+reward {
+		t.Fatalf("wrong issued %d after the first reward", issued)
+	}
+}
+
+func TestTransaction_VerifyUnsignedTx(t *testing.T) {
+	key, _ := secp256k1.GeneratePrivateKey()
+	from := hasharry.StringToAddress(ut.GenerateUWDAddress(param.Net, key.PubKey()))
+	to := hasharry.StringToAddress("UWDNQhgkNHCLdVhCFvpo6bGXXdcKtTTfeQZE")
+
+	tx := newTestTx(from, to, 1, param.AtomsPerCoin)
+	tx.SetHash()
+	if err := tx.VerifyUnsignedTx(); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.VerifyTx(); err != ErrSignature {
+		t.Fatalf("expected ErrSignature, got %v", err)
+	}
+	if err := tx.SignTx(key); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.VerifyTx(); err != nil {
+		t.Fatal(err)
+	}
+
+	tx.TxHead.Fees = param.Fees - 1
+	tx.SetHash()
+	if err := tx.VerifyUnsignedTx(); err == nil {
+		t.Fatal("the fees are lower than the minimum")
+	}
+}
+
+func TestTransaction_ValidUntil(t *testing.T) {
+	key, _ := secp256k1.GeneratePrivateKey()
+	from := hasharry.StringToAddress(ut.GenerateUWDAddress(param.Net, key.PubKey()))
+	tx := newTestTx(from, hasharry.StringToAddress("UWDNQhgkNHCLdVhCFvpo6bGXXdcKtTTfeQZE"), 1, param.AtomsPerCoin)
+	tx.SetHash()
+	unlimited := tx.Hash()
+	if tx.IsExpired(1 << 40) {
+		t.Fatal("a transaction without the height does not expire")
+	}
+
+	tx.TxHead.ValidUntil = 100
+	tx.SetHash()
+	if tx.Hash().IsEqual(unlimited) {
+		t.Fatal("the height should be covered by the hash")
+	}
+	if tx.IsExpired(100) || !tx.IsExpired(101) {
+		t.Fatal("the transaction can be included up to the height")
+	}
+	tx.SignTx(key)
+
+	bytes, _ := rlp.EncodeToBytes(tx.TranslateToRlpTransaction())
+	var rlpTx *RlpTransaction
+	if err := rlp.DecodeBytes(bytes, &rlpTx); err != nil {
+		t.Fatal(err)
+	}
+	decoded := rlpTx.TranslateToTransaction()
+	if decoded.TxHead.ValidUntil != 100 {
+		t.Fatalf("wrong height %d", decoded.TxHead.ValidUntil)
+	}
+	if err := decoded.VerifyTx(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -30,10 +30,19 @@ func (s Transactions) Hash() hash2.Hash {
 	return hash.Hash(hashBytes)
 }
 
+// Total size of the transactions in bytes
+func (s Transactions) Size() uint64 {
+	var size uint64
+	for _, tx := range s {
+		size += tx.Size()
+	}
+	return size
+}
+
 func (s Transactions) SumFees() uint64 {
 	var sum uint64
 	for _, tx := range s {
-		if tx.GetTxType() != ContractTransaction {
+		if !isConsumption(tx) {
 			sum += tx.GetFees()
 		}
 	}
@@ -43,9 +52,19 @@ func (s Transactions) SumFees() uint64 {
 func (s Transactions) SumConsumption() uint64 {
 	var sum uint64
 	for _, tx := range s {
-		if tx.GetTxType() == ContractTransaction {
+		if isConsumption(tx) {
 			sum += tx.GetFees()
 		}
 	}
 	return sum
 }
+
+// The fees of publishing a contract and registering or renewing
+// a name are consumed instead of being paid to the block producer
+func isConsumption(tx ITransaction) bool {
+	switch tx.GetTxType() {
+	case ContractTransaction, NameRegisterTransaction, NameRenewTransaction:
+		return true
+	}
+	return false
+}
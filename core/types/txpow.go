@@ -0,0 +1,120 @@
+package types
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/crypto/cuckoo"
+	"github.com/uworldao/UWORLD/crypto/hash"
+	"github.com/uworldao/UWORLD/param"
+)
+
+// Proof of work that replaces the fees of a transaction, a cuckoo
+// cycle of the graph keyed by the transaction hash and the nonce
+type TxPoW struct {
+	Nonce uint64
+	Cycle []uint32
+}
+
+// Solve the proof of work with the number of leading zero bits over
+// the hash of the transaction, so the hash must be set before. It takes
+// a few seconds for each graph and uses several hundred megabytes of
+// memory, each more bit doubles the expected number of graphs.
+func (t *Transaction) SolvePoW(bits int) {
+	c := cuckoo.NewCuckoo()
+	for nonce := uint64(0); ; nonce++ {
+		key := txPoWKey(t.TxHead.TxHash, nonce)
+		cycle, ok := c.PoW(key)
+		if !ok || cuckoo.Verify(key, cycle) != nil || cycleZeroBits(cycle) < bits {
+			continue
+		}
+		t.TxHead.PoW = &TxPoW{Nonce: nonce, Cycle: cycle}
+		return
+	}
+}
+
+// Whether the transaction carries a proof of work instead of fees
+func (t *Transaction) HasPoW() bool {
+	return t.TxHead.PoW != nil
+}
+
+// Only the transactions that cost the normal fees can replace them
+// with a proof of work
+func (t *Transaction) verifyTxPoW() error {
+	pow := t.TxHead.PoW
+	if pow == nil {
+		return nil
+	}
+	switch t.TxHead.TxType {
+	case ContractTransaction, NameRegisterTransaction, NameRenewTransaction:
+		return errors.New("the fees of the transaction cannot be replaced by proof of work")
+	}
+	if len(pow.Cycle) != cuckoo.ProofSize {
+		return ErrTxPoW
+	}
+	if err := cuckoo.Verify(txPoWKey(t.TxHead.TxHash, pow.Nonce), pow.Cycle); err != nil {
+		return ErrTxPoW
+	}
+	if cycleZeroBits(pow.Cycle) < param.MinTxPoWBits {
+		return ErrTxPoW
+	}
+	return nil
+}
+
+// The number of leading zero bits of the proof of work, 0 if the
+// transaction has no proof of work
+func (t *Transaction) PoWBits() int {
+	if t.TxHead.PoW == nil {
+		return 0
+	}
+	return cycleZeroBits(t.TxHead.PoW.Cycle)
+}
+
+// Verify that the transaction pays the minimum fee of the block, either
+// by the fees or by a proof of work that meets the target of the fee
+func (t *Transaction) VerifyMinFees(minFees uint64) error {
+	if t.TxHead.Fees >= minFees {
+		return nil
+	}
+	if !t.HasPoW() {
+		return fmt.Errorf("transaction costs at least %d fees", minFees)
+	}
+	if bits := TxPoWBits(minFees); t.PoWBits() < bits {
+		return fmt.Errorf("the proof of work of the transaction requires %d zero bits", bits)
+	}
+	return nil
+}
+
+// The number of leading zero bits of the proof of work required by the
+// minimum fee, it rises with the minimum fee as the blocks become full
+func TxPoWBits(minFees uint64) int {
+	bits := param.MinTxPoWBits
+	for fees := param.Fees * 2; fees <= minFees && bits < param.MaxTxPoWBits; fees *= 2 {
+		bits++
+	}
+	return bits
+}
+
+// The siphash key of the graph
+func txPoWKey(txHash hasharry.Hash, nonce uint64) []byte {
+	bytes := make([]byte, len(txHash)+8)
+	copy(bytes, txHash.Bytes())
+	binary.BigEndian.PutUint64(bytes[len(txHash):], nonce)
+	key := hash.Hash(bytes)
+	return key.Bytes()[:16]
+}
+
+// The number of leading zero bits of the hash of the cycle
+func cycleZeroBits(cycle []uint32) int {
+	bytes := make([]byte, 4*len(cycle))
+	for i, nonce := range cycle {
+		binary.LittleEndian.PutUint32(bytes[4*i:], nonce)
+	}
+	cycleHash := hash.Hash(bytes)
+	bits := 0
+	for bits < len(cycleHash)*8 && cycleHash[bits/8]&(0x80>>uint(bits%8)) == 0 {
+		bits++
+	}
+	return bits
+}
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"github.com/uworldao/UWORLD/common/encode/rlp"
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/crypto/cuckoo"
+	"github.com/uworldao/UWORLD/crypto/ecc/secp256k1"
+	"github.com/uworldao/UWORLD/param"
+	"github.com/uworldao/UWORLD/ut"
+	"testing"
+)
+
+func TestTransaction_PoW(t *testing.T) {
+	key, _ := secp256k1.GeneratePrivateKey()
+	from := hasharry.StringToAddress(ut.GenerateUWDAddress(param.Net, key.PubKey()))
+	tx := newTestTx(from, from, 1, param.AtomsPerCoin)
+	tx.TxHead.Fees = 0
+	tx.SetHash()
+	tx.SignTx(key)
+	if err := tx.VerifyTx(); err == nil {
+		t.Fatal("transaction without fees and proof of work is valid")
+	}
+
+	tx.TxHead.PoW = &TxPoW{Nonce: 1, Cycle: make([]uint32, cuckoo.ProofSize)}
+	if err := tx.VerifyTx(); err != ErrTxPoW {
+		t.Fatalf("expected ErrTxPoW, got %v", err)
+	}
+
+	if testing.Short() {
+		t.Skip("solving the proof of work is slow")
+	}
+	tx.SolvePoW(param.MinTxPoWBits)
+	if err := tx.VerifyTx(); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.VerifyMinFees(param.Fees); err != nil {
+		t.Fatal(err)
+	}
+	// The proof is below the target when the minimum fee rises
+	if bits := tx.PoWBits(); bits < param.MaxTxPoWBits {
+		if err := tx.VerifyMinFees(param.Fees << uint(bits-param.MinTxPoWBits+1)); err == nil {
+			t.Fatal("the proof below the target should be rejected")
+		}
+	}
+
+	bytes, _ := rlp.EncodeToBytes(tx.TranslateToRlpTransaction())
+	var rlpTx *RlpTransaction
+	if err := rlp.DecodeBytes(bytes, &rlpTx); err != nil {
+		t.Fatal(err)
+	}
+	if err := rlpTx.TranslateToTransaction().VerifyTx(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The proof is over the hash, it cannot be moved to another transaction
+	other := newTestTx(from, from, 2, param.AtomsPerCoin)
+	other.TxHead.Fees = 0
+	other.SetHash()
+	other.SignTx(key)
+	other.TxHead.PoW = tx.TxHead.PoW
+	if err := other.VerifyTx(); err != ErrTxPoW {
+		t.Fatalf("expected ErrTxPoW, got %v", err)
+	}
+}
+
+func TestTransaction_VerifyMinFees(t *testing.T) {
+	if TxPoWBits(param.Fees) != param.MinTxPoWBits || TxPoWBits(param.Fees*3) != param.MinTxPoWBits+1 {
+		t.Fatal("wrong target of the proof of work")
+	}
+	if TxPoWBits(param.Fees<<param.MaxTxPoWBits) != param.MaxTxPoWBits {
+		t.Fatal("the target should be bounded")
+	}
+
+	key, _ := secp256k1.GeneratePrivateKey()
+	from := hasharry.StringToAddress(ut.GenerateUWDAddress(param.Net, key.PubKey()))
+	tx := newTestTx(from, from, 1, param.AtomsPerCoin)
+	if err := tx.VerifyMinFees(param.Fees); err != nil {
+		t.Fatal(err)
+	}
+	tx.TxHead.Fees = 0
+	if err := tx.VerifyMinFees(param.Fees); err == nil {
+		t.Fatal("transaction without fees and proof of work should be rejected")
+	}
+
+	// A cycle of exactly the minimum bits, only its hash is checked here
+	cycle := make([]uint32, cuckoo.ProofSize)
+	for cycleZeroBits(cycle) != param.MinTxPoWBits {
+		cycle[0]++
+	}
+	tx.TxHead.PoW = &TxPoW{Cycle: cycle}
+	if err := tx.VerifyMinFees(param.Fees); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.VerifyMinFees(param.Fees * 2); err == nil {
+		t.Fatal("the proof below the target should be rejected")
+	}
+	tx.TxHead.Fees = param.Fees * 2
+	if err := tx.VerifyMinFees(param.Fees * 2); err != nil {
+		t.Fatal(err)
+	}
+}
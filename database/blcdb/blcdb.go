@@ -15,11 +15,16 @@ const (
 	transactionBucket = "transactionBucket"
 	heightHash        = "heightHash"
 	locationBucket    = "locationBucket"
+	anchorBucket      = "anchorBucket"
+	pubKeyBucket      = "pubKeyBucket"
 	stateRoot         = "stateRoot"
 	contractRoot      = "contractRoot"
 	consensusRoot     = "consensusRoot"
+	htlcRoot          = "htlcRoot"
+	nameRoot          = "nameRoot"
 	historyConfirmed  = "historyConfirmed"
 	termLastHash      = "termLastHash"
+	minFees           = "minFees"
 )
 
 type BlockChainStorage struct {
@@ -80,6 +85,30 @@ func (b *BlockChainStorage) GetTxLocation(hash hasharry.Hash) (*types.TxLocation
 	return txLoc, err
 }
 
+func (b *BlockChainStorage) GetAnchor(digest hasharry.Hash) (hasharry.Hash, error) {
+	key := leveldb.GetKey(anchorBucket, digest.Bytes())
+	bytes, err := b.db.GetValue(key)
+	if err != nil {
+		return hasharry.Hash{}, err
+	}
+	if bytes == nil || len(bytes) == 0 {
+		return hasharry.Hash{}, fmt.Errorf("digest %s is not anchored", digest.String())
+	}
+	return hasharry.BytesToHash(bytes), nil
+}
+
+func (b *BlockChainStorage) GetPubKey(address hasharry.Address) ([]byte, error) {
+	key := leveldb.GetKey(pubKeyBucket, address.Bytes())
+	bytes, err := b.db.GetValue(key)
+	if err != nil {
+		return nil, err
+	}
+	if bytes == nil || len(bytes) == 0 {
+		return nil, fmt.Errorf("the public key of %s is unknown", address.String())
+	}
+	return bytes, nil
+}
+
 func (b *BlockChainStorage) GetTransactions(txRoot hasharry.Hash) ([]*types.RlpTransaction, error) {
 	var txs []*types.RlpTransaction
 	key := leveldb.GetKey(transactionBucket, txRoot.Bytes())
@@ -129,6 +158,15 @@ func (b *BlockChainStorage) GetHistoryConfirmedHeight(height uint64) (uint64, er
 	return strconv.ParseUint(string(heightBytes), 10, 64)
 }
 
+func (b *BlockChainStorage) GetMinFees(height uint64) (uint64, error) {
+	key := leveldb.GetKey(minFees, []byte(strconv.FormatUint(height, 10)))
+	feesBytes, err := b.db.GetValue(key)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(string(feesBytes), 10, 64)
+}
+
 func (b *BlockChainStorage) GetStateRoot() (hasharry.Hash, error) {
 	rootBytes, err := b.db.GetValue([]byte(stateRoot))
 	if err != nil {
@@ -153,6 +191,22 @@ func (b *BlockChainStorage) GetConsensusRoot() (hasharry.Hash, error) {
 	return hasharry.BytesToHash(rootBytes), nil
 }
 
+func (b *BlockChainStorage) GetHTLCRoot() (hasharry.Hash, error) {
+	rootBytes, err := b.db.GetValue([]byte(htlcRoot))
+	if err != nil {
+		return hasharry.Hash{}, err
+	}
+	return hasharry.BytesToHash(rootBytes), nil
+}
+
+func (b *BlockChainStorage) GetNameRoot() (hasharry.Hash, error) {
+	rootBytes, err := b.db.GetValue([]byte(nameRoot))
+	if err != nil {
+		return hasharry.Hash{}, err
+	}
+	return hasharry.BytesToHash(rootBytes), nil
+}
+
 func (b *BlockChainStorage) GetTermLastHash(term uint64) (hasharry.Hash, error) {
 	bytes := []byte(strconv.FormatUint(term, 10))
 	key := leveldb.GetKey(termLastHash, bytes)
@@ -189,6 +243,20 @@ func (b *BlockChainStorage) UpdateTxLocation(txLocs map[hasharry.Hash]*types.TxL
 	}
 }
 
+func (b *BlockChainStorage) UpdateAnchors(anchors map[hasharry.Hash]hasharry.Hash) {
+	for digest, txHash := range anchors {
+		key := leveldb.GetKey(anchorBucket, digest.Bytes())
+		b.db.UpdateValue(key, txHash.Bytes())
+	}
+}
+
+func (b *BlockChainStorage) UpdatePubKeys(pubKeys map[hasharry.Address][]byte) {
+	for address, pubKey := range pubKeys {
+		key := leveldb.GetKey(pubKeyBucket, address.Bytes())
+		b.db.UpdateValue(key, pubKey)
+	}
+}
+
 func (b *BlockChainStorage) UpdateHeightHash(height uint64, hash hasharry.Hash) {
 	bytes := []byte(strconv.FormatUint(height, 10))
 	key := leveldb.GetKey(heightHash, bytes)
@@ -207,6 +275,14 @@ func (b *BlockChainStorage) UpdateConsensusRoot(hash hasharry.Hash) {
 	b.db.UpdateValue([]byte(consensusRoot), hash.Bytes())
 }
 
+func (b *BlockChainStorage) UpdateHTLCRoot(hash hasharry.Hash) {
+	b.db.UpdateValue([]byte(htlcRoot), hash.Bytes())
+}
+
+func (b *BlockChainStorage) UpdateNameRoot(hash hasharry.Hash) {
+	b.db.UpdateValue([]byte(nameRoot), hash.Bytes())
+}
+
 func (b *BlockChainStorage) UpdateHistoryConfirmedHeight(height uint64, confirmedHeight uint64) {
 	heightBytes := []byte(strconv.FormatUint(height, 10))
 	confirmedBytes := []byte(strconv.FormatUint(confirmedHeight, 10))
@@ -214,6 +290,13 @@ func (b *BlockChainStorage) UpdateHistoryConfirmedHeight(height uint64, confirme
 	b.db.UpdateValue(key, confirmedBytes)
 }
 
+func (b *BlockChainStorage) UpdateMinFees(height uint64, fees uint64) {
+	heightBytes := []byte(strconv.FormatUint(height, 10))
+	feesBytes := []byte(strconv.FormatUint(fees, 10))
+	key := leveldb.GetKey(minFees, heightBytes)
+	b.db.UpdateValue(key, feesBytes)
+}
+
 func (b *BlockChainStorage) UpdateTermLastHash(term uint64, hash hasharry.Hash) {
 	bytes := []byte(strconv.FormatUint(term, 10))
 	key := leveldb.GetKey(termLastHash, bytes)
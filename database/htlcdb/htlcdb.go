@@ -0,0 +1,80 @@
+package htlcdb
+
+import (
+	"github.com/uworldao/UWORLD/common/codec"
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/core/types"
+	"github.com/uworldao/UWORLD/database/triedb"
+	"github.com/uworldao/UWORLD/trie"
+)
+
+// Root hash of a trie without any node
+var emptyRoot = new(trie.Trie).Hash()
+
+type HTLCStorage struct {
+	trieDB   *triedb.TrieDB
+	htlcTrie *trie.Trie
+}
+
+func NewHTLCStorage(path string) *HTLCStorage {
+	trieDB := triedb.NewTrieDB(path)
+	return &HTLCStorage{trieDB, nil}
+}
+
+func (h *HTLCStorage) InitTrie(htlcRoot hasharry.Hash) error {
+	htlcTrie, err := trie.New(htlcRoot, h.trieDB)
+	if err != nil {
+		return err
+	}
+	h.htlcTrie = htlcTrie
+	return nil
+}
+
+// The root of the empty trie is an empty hash, so that the
+// headers of the blocks before any HTLC remain unchanged.
+func (h *HTLCStorage) Commit() (hasharry.Hash, error) {
+	root, err := h.htlcTrie.Commit()
+	if err != nil {
+		return root, err
+	}
+	return trimRoot(root), nil
+}
+
+func (h *HTLCStorage) RootHash() hasharry.Hash {
+	return trimRoot(h.htlcTrie.Hash())
+}
+
+func (h *HTLCStorage) Open() error {
+	return h.trieDB.Open()
+}
+
+func (h *HTLCStorage) Close() error {
+	return h.trieDB.Close()
+}
+
+func (h *HTLCStorage) GetHTLC(id hasharry.Hash) *types.HTLC {
+	bytes := h.htlcTrie.Get(id.Bytes())
+	if len(bytes) == 0 {
+		return nil
+	}
+	var htlc *types.HTLC
+	if err := codec.FromBytes(bytes, &htlc); err != nil {
+		return nil
+	}
+	return htlc
+}
+
+func (h *HTLCStorage) SetHTLC(htlc *types.HTLC) {
+	bytes, err := codec.ToBytes(htlc)
+	if err != nil {
+		return
+	}
+	h.htlcTrie.Update(htlc.Id.Bytes(), bytes)
+}
+
+func trimRoot(root hasharry.Hash) hasharry.Hash {
+	if root.IsEqual(emptyRoot) {
+		return hasharry.Hash{}
+	}
+	return root
+}
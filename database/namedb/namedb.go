@@ -0,0 +1,112 @@
+package namedb
+
+import (
+	"github.com/uworldao/UWORLD/common/codec"
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/core/types"
+	"github.com/uworldao/UWORLD/database/triedb"
+	"github.com/uworldao/UWORLD/trie"
+)
+
+const (
+	namePrefix  = "name_"
+	ownerPrefix = "owner_"
+)
+
+// Root hash of a trie without any node
+var emptyRoot = new(trie.Trie).Hash()
+
+// Names and the names registered for each owner are
+// stored in the same trie with different prefixes.
+type NameStorage struct {
+	trieDB   *triedb.TrieDB
+	nameTrie *trie.Trie
+}
+
+func NewNameStorage(path string) *NameStorage {
+	trieDB := triedb.NewTrieDB(path)
+	return &NameStorage{trieDB, nil}
+}
+
+func (n *NameStorage) InitTrie(nameRoot hasharry.Hash) error {
+	nameTrie, err := trie.New(nameRoot, n.trieDB)
+	if err != nil {
+		return err
+	}
+	n.nameTrie = nameTrie
+	return nil
+}
+
+// The root of the empty trie is an empty hash, so that the
+// headers of the blocks before any name remain unchanged.
+func (n *NameStorage) Commit() (hasharry.Hash, error) {
+	root, err := n.nameTrie.Commit()
+	if err != nil {
+		return root, err
+	}
+	return trimRoot(root), nil
+}
+
+func (n *NameStorage) RootHash() hasharry.Hash {
+	return trimRoot(n.nameTrie.Hash())
+}
+
+func (n *NameStorage) Open() error {
+	return n.trieDB.Open()
+}
+
+func (n *NameStorage) Close() error {
+	return n.trieDB.Close()
+}
+
+func (n *NameStorage) GetName(name string) *types.Name {
+	bytes := n.nameTrie.Get([]byte(namePrefix + name))
+	if len(bytes) == 0 {
+		return nil
+	}
+	var nameInfo *types.Name
+	if err := codec.FromBytes(bytes, &nameInfo); err != nil {
+		return nil
+	}
+	return nameInfo
+}
+
+func (n *NameStorage) SetName(name *types.Name) {
+	bytes, err := codec.ToBytes(name)
+	if err != nil {
+		return
+	}
+	n.nameTrie.Update([]byte(namePrefix+name.Name), bytes)
+}
+
+func (n *NameStorage) GetOwnerNames(owner hasharry.Address) []string {
+	bytes := n.nameTrie.Get([]byte(ownerPrefix + owner.String()))
+	if len(bytes) == 0 {
+		return nil
+	}
+	var names []string
+	if err := codec.FromBytes(bytes, &names); err != nil {
+		return nil
+	}
+	return names
+}
+
+func (n *NameStorage) SetOwnerNames(owner hasharry.Address, names []string) {
+	key := []byte(ownerPrefix + owner.String())
+	if len(names) == 0 {
+		n.nameTrie.Delete(key)
+		return
+	}
+	bytes, err := codec.ToBytes(names)
+	if err != nil {
+		return
+	}
+	n.nameTrie.Update(key, bytes)
+}
+
+func trimRoot(root hasharry.Hash) hasharry.Hash {
+	if root.IsEqual(emptyRoot) {
+		return hasharry.Hash{}
+	}
+	return root
+}
@@ -10,8 +10,15 @@ import (
 const (
 	futureTxs  = "futureTxs"
 	prepareTxs = "prepareTxs"
+	localTxs   = "localTxs"
 )
 
+type rlpLocalTx struct {
+	Tx     *types.RlpTransaction
+	Time   uint64
+	Reason string
+}
+
 type TxPoolStorage struct {
 	db *leveldb.Base
 }
@@ -46,6 +53,21 @@ func (t *TxPoolStorage) LoadPreparesTxs() *list.TxSortedMap {
 	return prepare
 }
 
+func (t *TxPoolStorage) LoadLocalTxs() *list.LocalTxs {
+	locals := list.NewLocalTxs()
+	rs := t.db.Foreach(localTxs)
+	for _, value := range rs {
+		var rlpLocal *rlpLocalTx
+		if err := rlp.DecodeBytes(value, &rlpLocal); err != nil {
+			continue
+		}
+		tx := rlpLocal.Tx.TranslateToTransaction()
+		locals.Put(tx, rlpLocal.Time)
+		locals.Drop(tx, rlpLocal.Reason)
+	}
+	return locals
+}
+
 func (t *TxPoolStorage) SaveFutureTxs(future *list.FutureTxList) {
 	t.db.ClearBucket(futureTxs)
 	for _, tx := range future.Txs {
@@ -66,6 +88,20 @@ func (t *TxPoolStorage) SavePreparesTxs(prepare *list.TxSortedMap) {
 	}
 }
 
+func (t *TxPoolStorage) SaveLocalTxs(locals *list.LocalTxs) {
+	t.db.ClearBucket(localTxs)
+	for _, local := range locals.Txs {
+		rlpLocal := &rlpLocalTx{
+			Tx:     local.Tx.TranslateToRlpTransaction(),
+			Time:   local.Time,
+			Reason: local.Reason,
+		}
+		bytes, _ := rlp.EncodeToBytes(rlpLocal)
+		key := leveldb.GetKey(localTxs, local.Tx.Hash().Bytes())
+		t.db.UpdateValue(key, bytes)
+	}
+}
+
 func (t *TxPoolStorage) Close() error {
 	return t.db.Db.Close()
 }
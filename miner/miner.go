@@ -12,7 +12,6 @@ import (
 )
 
 const resultChanSize = 10
-const maxBlockTransactions = 999
 
 // Generate block miner
 type Miner struct {
@@ -103,13 +102,15 @@ func (miner *Miner) mintingBlock(now uint64) {
 	if currentHeader.Time > now {
 		now = currentHeader.Time + 1
 	}
-	stateRoot, contractRoot, consensusRoot := miner.blockChain.TireRoot()
+	stateRoot, contractRoot, consensusRoot, htlcRoot, nameRoot := miner.blockChain.TireRoot()
 
 	// Build block header
 	header := &types.Header{
 		StateRoot:     stateRoot,
 		ContractRoot:  contractRoot,
 		ConsensusRoot: consensusRoot,
+		HTLCRoot:      htlcRoot,
+		NameRoot:      nameRoot,
 		ParentHash:    currentHeader.Hash,
 		Height:        currentHeader.Height + 1,
 		Time:          now,
@@ -143,11 +144,13 @@ func (miner *Miner) generateBlock(header *types.Header) (*types.Block, error) {
 
 // Get transactions from the transaction pool and generate coinbase transactions
 func (miner *Miner) getTransactions(height uint64) types.Transactions {
-	txs := miner.txPool.Gets(maxBlockTransactions)
-	coinBase := miner.getCoinBase(txs, height)
+	coinBase := miner.getCoinBase(nil, height)
 	coinBaseTx := miner.generateCoinBaseTx(coinBase)
 	coinBaseTx.SetHash()
-	txs = append(txs, coinBaseTx)
+	template := miner.txPool.GetBlockTemplate(param.MaxBlockTransactions, param.MaxBlockSize-coinBaseTx.Size())
+	// The transactions that became invalid will not be included later
+	miner.txPool.RemoveRejected(template.Invalid)
+	txs := append(template.Txs, coinBaseTx)
 	return txs
 }
 
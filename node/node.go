@@ -18,6 +18,8 @@ import (
 	"github.com/uworldao/UWORLD/services/accountstate"
 	"github.com/uworldao/UWORLD/services/blkmgr"
 	"github.com/uworldao/UWORLD/services/contractstate"
+	"github.com/uworldao/UWORLD/services/htlcstate"
+	"github.com/uworldao/UWORLD/services/namestate"
 	"github.com/uworldao/UWORLD/services/peermgr"
 	"github.com/uworldao/UWORLD/services/reqmgr"
 	"github.com/uworldao/UWORLD/services/txmgr"
@@ -44,7 +46,7 @@ func NewNode(cfg *config.Config) (*Node, error) {
 	node := &Node{}
 	revBlkCh := make(chan *types.Block, 100)
 	genBlkCh := make(chan *types.Block, 20)
-	revTxCh := make(chan types.ITransaction, 50)
+	revTxCh := make(chan *core.PeerTx, 50)
 	minerWorkCh := make(chan bool)
 	stateUpdateChan := make(chan struct{}, 50)
 	removeTxsCh := make(chan types.Transactions, 100)
@@ -61,21 +63,33 @@ func NewNode(cfg *config.Config) (*Node, error) {
 		return nil, fmt.Errorf("create contract state failed! err:%s", err)
 	}
 
+	htlcState, err := htlcstate.NewHTLCState(cfg.DataDir)
+	if err != nil {
+		return nil, fmt.Errorf("create htlc state failed! err:%s", err)
+	}
+
+	nameState, err := namestate.NewNameState(cfg.DataDir)
+	if err != nil {
+		return nil, fmt.Errorf("create name state failed! err:%s", err)
+	}
+
 	if node.consensus, err = dpos.NewDPos(cfg.DataDir, cfg.NodePrivate.Address, node); err != nil {
 		return nil, fmt.Errorf("create dpos failed! err:%s", err)
 	}
 
-	if node.blockChain, err = core.NewBlockChain(cfg.DataDir, node.consensus, stateUpdateChan, removeTxsCh, accountState, contractState); err != nil {
+	if node.blockChain, err = core.NewBlockChain(cfg.DataDir, node.consensus, stateUpdateChan, removeTxsCh, accountState, contractState, htlcState, nameState); err != nil {
 		return nil, fmt.Errorf("create block chain failed! err:%s", err)
 	}
 
-	node.network = reqmgr.NewRequestManger(node.blockChain, revBlkCh, revTxCh, node)
+	requestManager := reqmgr.NewRequestManger(node.blockChain, revBlkCh, revTxCh, node)
+	node.network = requestManager
 
 	if node.p2pServer, err = p2p.NewP2pServer(cfg, node.localNode, node.peerManager, node.network); err != nil {
 		return nil, fmt.Errorf("create p2p server failed! err:%s", err)
 	}
 
-	node.txPool = txmgr.NewTxPool(cfg, accountState, contractState, node.consensus, node.peerManager, node.network, revTxCh, stateUpdateChan, removeTxsCh, node.p2pServer)
+	node.txPool = txmgr.NewTxPool(cfg, node.blockChain, accountState, contractState, htlcState, nameState, node.consensus, node.peerManager, node.network, revTxCh, stateUpdateChan, removeTxsCh, node.p2pServer)
+	requestManager.SetTxPool(node.txPool)
 
 	if err := node.consensus.Init(node.blockChain); err != nil {
 		return nil, fmt.Errorf("init consensus failed! err:%s", err)
@@ -85,7 +99,7 @@ func NewNode(cfg *config.Config) (*Node, error) {
 	node.blockManger = blkmgr.NewBlockManager(node.blockChain, node.peerManager, node.network, node.consensus, revBlkCh, genBlkCh, minerWorkCh, node.p2pServer)
 	node.private = cfg.NodePrivate
 	rpcConfig := &config.RpcConfig{DataDir: cfg.DataDir, RpcPort: cfg.RpcPort, RpcTLS: cfg.RpcTLS, RpcCert: cfg.RpcCert, RpcPass: cfg.RpcPass}
-	node.rpcServer = rpc.NewServer(rpcConfig, node.txPool, accountState, contractState, node.consensus, node.blockChain, node.peerManager, node)
+	node.rpcServer = rpc.NewServer(rpcConfig, node.txPool, accountState, contractState, htlcState, nameState, node.consensus, node.blockChain, node.peerManager, node)
 
 	if cfg.FallBackTo != config.DefaultFallBack && cfg.FallBackTo > 0 {
 		if err := node.blockChain.FallBackTo(uint64(cfg.FallBackTo)); err != nil {
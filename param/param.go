@@ -21,6 +21,8 @@ var (
 
 	FeeAddress   = hasharry.StringToAddress("UWDNQhgkNHCLdVhCFvpo6bGXXdcKtTTfeQZE")
 	EaterAddress = hasharry.StringToAddress("UWDCoinEaterAddressDontSend000000000")
+	// The UWD burned by the holders is kept by the address
+	BurnAddress = hasharry.StringToAddress("UWDCoinBurnedAddressDontSend00000000")
 )
 
 const (
@@ -59,6 +61,10 @@ const (
 	// MaxFeesCoefficient is maximum fee required to process the transaction
 	MaxFeesCoefficient uint64 = 1 * AtomsPerCoin
 
+	// MaxFees is the highest fee a normal transaction can pay, the
+	// minimum fee does not rise above it
+	MaxFees uint64 = 1 * AtomsPerCoin
+
 	// MinAllowedAmount is the minimum allowable amount for a transaction
 	MinAllowedAmount uint64 = 0.005 * AtomsPerCoin
 
@@ -68,10 +74,41 @@ const (
 	// MaxContractCoin is the maximum allowable contract COINS
 	MaxContractCoin uint64 = 1e10 * AtomsPerCoin
 
+	// Fees is the lowest fee of a normal transaction, the minimum fee
+	// actually required is adjusted according to the fullness of the block
 	Fees uint64 = 0.002 * AtomsPerCoin
 
+	// MinTxPoWBits is the number of leading zero bits of the hash of the
+	// cuckoo cycle that replaces the fees of a transaction when the minimum
+	// fee is Fees, one more bit is required each time the minimum fee
+	// doubles, up to MaxTxPoWBits
+	MinTxPoWBits = 2
+	MaxTxPoWBits = 10
+
+	// MaxBlockTransactions is the maximum number of transactions in a block
+	MaxBlockTransactions = 999
+
+	// MaxBlockSize is the maximum total size in bytes of the transactions
+	// in a block, including the coin base transaction
+	MaxBlockSize uint64 = 1024 * 1024
+
+	// TargetBlockTransactions is the number of transactions in a block at
+	// which the minimum fee stays unchanged
+	TargetBlockTransactions = MaxBlockTransactions / 2
+
+	// FeesChangeDenominator bounds the amount the minimum fee can change
+	// between blocks
+	FeesChangeDenominator = 8
+
 	TokenConsumption uint64 = 10.24 * AtomsPerCoin
 
+	// NameConsumption is the fee of registering or renewing a name
+	// for a period, it is consumed like the fee of publishing a contract
+	NameConsumption uint64 = 1 * AtomsPerCoin
+
+	// NamePeriod is the number of blocks that a name is registered or renewed for
+	NamePeriod = 60 * 60 * 24 * 365 / BlockInterval
+
 	CoinHeight = 1
 )
 
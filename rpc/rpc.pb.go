@@ -212,6 +212,361 @@ func (m *Null) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Null proto.InternalMessageInfo
 
+type TxSize struct {
+	Size                 uint64   `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxSize) Reset()         { *m = TxSize{} }
+func (m *TxSize) String() string { return proto.CompactTextString(m) }
+func (*TxSize) ProtoMessage()    {}
+func (*TxSize) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{5}
+}
+
+func (m *TxSize) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxSize.Unmarshal(m, b)
+}
+func (m *TxSize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxSize.Marshal(b, m, deterministic)
+}
+func (m *TxSize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxSize.Merge(m, src)
+}
+func (m *TxSize) XXX_Size() int {
+	return xxx_messageInfo_TxSize.Size(m)
+}
+func (m *TxSize) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxSize.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxSize proto.InternalMessageInfo
+
+func (m *TxSize) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type Name struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Name) Reset()         { *m = Name{} }
+func (m *Name) String() string { return proto.CompactTextString(m) }
+func (*Name) ProtoMessage()    {}
+func (*Name) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{6}
+}
+
+func (m *Name) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Name.Unmarshal(m, b)
+}
+func (m *Name) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Name.Marshal(b, m, deterministic)
+}
+func (m *Name) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Name.Merge(m, src)
+}
+func (m *Name) XXX_Size() int {
+	return xxx_messageInfo_Name.Size(m)
+}
+func (m *Name) XXX_DiscardUnknown() {
+	xxx_messageInfo_Name.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Name proto.InternalMessageInfo
+
+func (m *Name) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type AllowanceReq struct {
+	Owner                string   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Spender              string   `protobuf:"bytes,2,opt,name=spender,proto3" json:"spender,omitempty"`
+	Contract             string   `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AllowanceReq) Reset()         { *m = AllowanceReq{} }
+func (m *AllowanceReq) String() string { return proto.CompactTextString(m) }
+func (*AllowanceReq) ProtoMessage()    {}
+func (*AllowanceReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{7}
+}
+
+func (m *AllowanceReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AllowanceReq.Unmarshal(m, b)
+}
+func (m *AllowanceReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AllowanceReq.Marshal(b, m, deterministic)
+}
+func (m *AllowanceReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowanceReq.Merge(m, src)
+}
+func (m *AllowanceReq) XXX_Size() int {
+	return xxx_messageInfo_AllowanceReq.Size(m)
+}
+func (m *AllowanceReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowanceReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowanceReq proto.InternalMessageInfo
+
+func (m *AllowanceReq) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *AllowanceReq) GetSpender() string {
+	if m != nil {
+		return m.Spender
+	}
+	return ""
+}
+
+func (m *AllowanceReq) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+type TxParams struct {
+	From                 string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string   `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Contract             string   `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	Amount               uint64   `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Note                 string   `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	Nonce                uint64   `protobuf:"varint,6,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Fees                 uint64   `protobuf:"varint,7,opt,name=fees,proto3" json:"fees,omitempty"`
+	Validuntil           uint64   `protobuf:"varint,8,opt,name=validuntil,proto3" json:"validuntil,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxParams) Reset()         { *m = TxParams{} }
+func (m *TxParams) String() string { return proto.CompactTextString(m) }
+func (*TxParams) ProtoMessage()    {}
+func (*TxParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{8}
+}
+
+func (m *TxParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxParams.Unmarshal(m, b)
+}
+func (m *TxParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxParams.Marshal(b, m, deterministic)
+}
+func (m *TxParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxParams.Merge(m, src)
+}
+func (m *TxParams) XXX_Size() int {
+	return xxx_messageInfo_TxParams.Size(m)
+}
+func (m *TxParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxParams proto.InternalMessageInfo
+
+func (m *TxParams) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *TxParams) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *TxParams) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *TxParams) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *TxParams) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+func (m *TxParams) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *TxParams) GetFees() uint64 {
+	if m != nil {
+		return m.Fees
+	}
+	return 0
+}
+
+func (m *TxParams) GetValiduntil() uint64 {
+	if m != nil {
+		return m.Validuntil
+	}
+	return 0
+}
+
+type ContractParams struct {
+	From                 string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string   `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Abbr                 string   `protobuf:"bytes,4,opt,name=abbr,proto3" json:"abbr,omitempty"`
+	Amount               uint64   `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Increase             bool     `protobuf:"varint,6,opt,name=increase,proto3" json:"increase,omitempty"`
+	Description          string   `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	Note                 string   `protobuf:"bytes,8,opt,name=note,proto3" json:"note,omitempty"`
+	Nonce                uint64   `protobuf:"varint,9,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Fees                 uint64   `protobuf:"varint,10,opt,name=fees,proto3" json:"fees,omitempty"`
+	Validuntil           uint64   `protobuf:"varint,11,opt,name=validuntil,proto3" json:"validuntil,omitempty"`
+	Contract             string   `protobuf:"bytes,12,opt,name=contract,proto3" json:"contract,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContractParams) Reset()         { *m = ContractParams{} }
+func (m *ContractParams) String() string { return proto.CompactTextString(m) }
+func (*ContractParams) ProtoMessage()    {}
+func (*ContractParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{9}
+}
+
+func (m *ContractParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContractParams.Unmarshal(m, b)
+}
+func (m *ContractParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContractParams.Marshal(b, m, deterministic)
+}
+func (m *ContractParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractParams.Merge(m, src)
+}
+func (m *ContractParams) XXX_Size() int {
+	return xxx_messageInfo_ContractParams.Size(m)
+}
+func (m *ContractParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractParams proto.InternalMessageInfo
+
+func (m *ContractParams) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *ContractParams) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *ContractParams) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ContractParams) GetAbbr() string {
+	if m != nil {
+		return m.Abbr
+	}
+	return ""
+}
+
+func (m *ContractParams) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *ContractParams) GetIncrease() bool {
+	if m != nil {
+		return m.Increase
+	}
+	return false
+}
+
+func (m *ContractParams) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ContractParams) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+func (m *ContractParams) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *ContractParams) GetFees() uint64 {
+	if m != nil {
+		return m.Fees
+	}
+	return 0
+}
+
+func (m *ContractParams) GetValiduntil() uint64 {
+	if m != nil {
+		return m.Validuntil
+	}
+	return 0
+}
+
+func (m *ContractParams) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
 // The response message containing the greetings
 type Response struct {
 	Code                 int32    `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
@@ -226,7 +581,7 @@ func (m *Response) Reset()         { *m = Response{} }
 func (m *Response) String() string { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()    {}
 func (*Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{5}
+	return fileDescriptor_77a6da22d6a3feb1, []int{10}
 }
 
 func (m *Response) XXX_Unmarshal(b []byte) error {
@@ -274,36 +629,69 @@ func init() {
 	proto.RegisterType((*Hash)(nil), "rpc.Hash")
 	proto.RegisterType((*Height)(nil), "rpc.Height")
 	proto.RegisterType((*Null)(nil), "rpc.Null")
+	proto.RegisterType((*TxSize)(nil), "rpc.TxSize")
+	proto.RegisterType((*Name)(nil), "rpc.Name")
+	proto.RegisterType((*AllowanceReq)(nil), "rpc.AllowanceReq")
+	proto.RegisterType((*TxParams)(nil), "rpc.TxParams")
+	proto.RegisterType((*ContractParams)(nil), "rpc.ContractParams")
 	proto.RegisterType((*Response)(nil), "rpc.Response")
 }
 
 func init() { proto.RegisterFile("rpc.proto", fileDescriptor_77a6da22d6a3feb1) }
 
 var fileDescriptor_77a6da22d6a3feb1 = []byte{
-	// 360 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xef, 0x6a, 0xea, 0x40,
-	0x10, 0xc5, 0xaf, 0xd7, 0x24, 0xea, 0xa8, 0xf7, 0xca, 0x52, 0x4a, 0x10, 0x0a, 0x12, 0xa1, 0x58,
-	0x2c, 0x22, 0xed, 0x13, 0x68, 0x3f, 0xc4, 0x42, 0x11, 0x49, 0x7d, 0x81, 0xb8, 0x19, 0x1b, 0x69,
-	0xba, 0x1b, 0x76, 0x57, 0xa8, 0xcf, 0xd3, 0x17, 0x2d, 0x3b, 0x89, 0x54, 0x90, 0xa6, 0xfd, 0x76,
-	0x0e, 0xf3, 0xdb, 0xf9, 0x73, 0x60, 0xa1, 0xa5, 0x72, 0x3e, 0xc9, 0x95, 0x34, 0x92, 0xd5, 0x55,
-	0xce, 0x83, 0x2b, 0x70, 0xe7, 0x07, 0x83, 0x9a, 0x5d, 0x80, 0xbb, 0xb1, 0xc2, 0xaf, 0x0d, 0x6a,
-	0xa3, 0x4e, 0x54, 0x98, 0x60, 0x08, 0x8d, 0x59, 0x92, 0x28, 0xd4, 0x9a, 0xf9, 0xd0, 0x88, 0x0b,
-	0x49, 0x48, 0x2b, 0x3a, 0xda, 0xa0, 0x0f, 0xce, 0x22, 0xd6, 0x29, 0x63, 0xe0, 0xa4, 0xb1, 0x4e,
-	0xcb, 0x32, 0xe9, 0x60, 0x00, 0xde, 0x02, 0x77, 0x2f, 0xa9, 0x61, 0x97, 0xe0, 0xa5, 0xa4, 0xa8,
-	0xee, 0x44, 0xa5, 0x0b, 0x3c, 0x70, 0x96, 0xfb, 0x2c, 0x0b, 0x16, 0xd0, 0x8c, 0x50, 0xe7, 0x52,
-	0x68, 0xb4, 0x9d, 0xb8, 0x4c, 0x90, 0x48, 0x37, 0x22, 0x6d, 0xdf, 0x2b, 0xd4, 0xfb, 0xcc, 0xf8,
-	0x7f, 0x69, 0xc3, 0xd2, 0xb1, 0x1e, 0xd4, 0x51, 0x29, 0xbf, 0x4e, 0x43, 0xad, 0xbc, 0xfb, 0x70,
-	0xa0, 0x11, 0x2a, 0x44, 0x83, 0x8a, 0x4d, 0xe0, 0xff, 0x33, 0x8a, 0x64, 0xad, 0x62, 0xa1, 0x63,
-	0x6e, 0x76, 0x52, 0x30, 0x98, 0xd8, 0x0c, 0xe8, 0xea, 0x7e, 0x97, 0xf4, 0x71, 0x6e, 0xf0, 0x87,
-	0x8d, 0x01, 0x42, 0x34, 0x33, 0xce, 0xe5, 0x5e, 0x18, 0xd6, 0xa1, 0x72, 0x99, 0xc0, 0x39, 0x7c,
-	0x03, 0xed, 0x2f, 0x58, 0xb3, 0x16, 0xd5, 0xed, 0x31, 0xe7, 0xe8, 0x2d, 0xfc, 0x0b, 0xd1, 0x9c,
-	0xae, 0x51, 0xd0, 0x36, 0xb8, 0xef, 0xe8, 0x79, 0x26, 0xf9, 0xeb, 0xfc, 0x40, 0xd9, 0x56, 0xd1,
-	0x53, 0xe8, 0x9d, 0xd0, 0x45, 0xda, 0xed, 0x82, 0x27, 0x73, 0xfe, 0x62, 0x44, 0x57, 0xae, 0xa4,
-	0xcc, 0xd6, 0xef, 0xd5, 0x7b, 0x8f, 0xa1, 0x1b, 0xa2, 0x79, 0x8a, 0xb5, 0x29, 0x1b, 0x57, 0x1f,
-	0x69, 0xf3, 0x78, 0x90, 0xc2, 0xa8, 0x98, 0xff, 0x98, 0xde, 0x14, 0x58, 0x41, 0x6f, 0x77, 0xea,
-	0x0d, 0x93, 0x5f, 0xf4, 0x1f, 0x82, 0xbb, 0x42, 0x54, 0xd5, 0x1b, 0x5f, 0x43, 0x73, 0x29, 0x13,
-	0x7c, 0x14, 0x5b, 0x59, 0xc5, 0x6d, 0x3c, 0xfa, 0x05, 0xf7, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xa9, 0xe7, 0x9b, 0xf3, 0x12, 0x03, 0x00, 0x00,
+	// 809 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x6d, 0x6f, 0xe3, 0x44,
+	0x10, 0xa6, 0x6d, 0x5e, 0x9c, 0x49, 0x5a, 0xda, 0x3d, 0x84, 0x4c, 0x04, 0xa8, 0xf2, 0x09, 0x38,
+	0x38, 0x14, 0x4e, 0xbd, 0x5f, 0x90, 0x16, 0x48, 0x10, 0x51, 0x15, 0x39, 0xf9, 0xc4, 0xb7, 0xcd,
+	0x7a, 0x4a, 0xcc, 0xd9, 0xbb, 0x66, 0x77, 0xdd, 0x6b, 0xef, 0x37, 0x21, 0x7e, 0x03, 0x3f, 0x0d,
+	0xed, 0x8b, 0xdb, 0x34, 0x49, 0x9d, 0xdc, 0xb7, 0x19, 0xef, 0x33, 0xbb, 0xf3, 0x3c, 0xf3, 0x92,
+	0x40, 0x47, 0x16, 0x6c, 0x50, 0x48, 0xa1, 0x05, 0x39, 0x92, 0x05, 0x8b, 0xbe, 0x82, 0xe6, 0xe5,
+	0xbd, 0x46, 0x45, 0x3e, 0x83, 0xe6, 0xc2, 0x18, 0xe1, 0xc1, 0xf9, 0xc1, 0xab, 0x5e, 0xec, 0x9c,
+	0xe8, 0x25, 0xb4, 0x87, 0x49, 0x22, 0x51, 0x29, 0x12, 0x42, 0x9b, 0x3a, 0xd3, 0x42, 0x3a, 0x71,
+	0xe5, 0x46, 0x7d, 0x68, 0x8c, 0xa9, 0x5a, 0x12, 0x02, 0x8d, 0x25, 0x55, 0x4b, 0x7f, 0x6c, 0xed,
+	0xe8, 0x1c, 0x5a, 0x63, 0x4c, 0xff, 0x5c, 0x6a, 0xf2, 0x39, 0xb4, 0x96, 0xd6, 0xb2, 0xe7, 0x8d,
+	0xd8, 0x7b, 0x51, 0x0b, 0x1a, 0xd7, 0x65, 0x96, 0x45, 0x5f, 0x42, 0x6b, 0x7e, 0x37, 0x4b, 0x3f,
+	0xa0, 0xb9, 0x47, 0xa5, 0x1f, 0xd0, 0xe3, 0xac, 0x6d, 0xde, 0xb8, 0xa6, 0xb9, 0x3d, 0xe3, 0x34,
+	0xc7, 0xea, 0x0d, 0x63, 0x47, 0x7f, 0x40, 0x6f, 0x98, 0x65, 0xe2, 0x3d, 0xe5, 0x0c, 0x63, 0xfc,
+	0xdb, 0x50, 0x11, 0xef, 0x39, 0x4a, 0x0f, 0x72, 0x8e, 0xc9, 0x5f, 0x15, 0xc8, 0x13, 0x94, 0xe1,
+	0xa1, 0xcb, 0xdf, 0xbb, 0xa4, 0x0f, 0x01, 0x13, 0x5c, 0x4b, 0xca, 0x74, 0x78, 0x64, 0x8f, 0x1e,
+	0xfc, 0xe8, 0xbf, 0x03, 0x08, 0xe6, 0x77, 0x53, 0x2a, 0x69, 0xae, 0xcc, 0xe3, 0x37, 0x52, 0xe4,
+	0xd5, 0xe3, 0xc6, 0x26, 0x27, 0x70, 0xa8, 0x85, 0xbf, 0xf1, 0x50, 0x8b, 0xba, 0xcb, 0x8c, 0x04,
+	0x34, 0x17, 0x25, 0xd7, 0x61, 0xc3, 0x49, 0xe0, 0x3c, 0x4b, 0x4a, 0x68, 0x0c, 0x9b, 0x9e, 0x94,
+	0xd0, 0x68, 0x48, 0x70, 0xc1, 0x19, 0x86, 0x2d, 0x0b, 0x75, 0x8e, 0xcd, 0x00, 0x51, 0x85, 0x6d,
+	0x27, 0x8d, 0xb1, 0xc9, 0xd7, 0x00, 0xb7, 0x34, 0x4b, 0x93, 0x92, 0xeb, 0x34, 0x0b, 0x03, 0x7b,
+	0xb2, 0xf2, 0x25, 0xfa, 0xe7, 0x10, 0x4e, 0xae, 0x7c, 0x0a, 0x1f, 0x41, 0xa4, 0x52, 0xfa, 0xe8,
+	0x51, 0x69, 0xf3, 0x8d, 0x2e, 0x16, 0xd2, 0xa6, 0xdf, 0x89, 0xad, 0xbd, 0x42, 0xaa, 0xf9, 0x84,
+	0x54, 0x1f, 0x82, 0x94, 0x33, 0x89, 0x54, 0x39, 0x0e, 0x41, 0xfc, 0xe0, 0x93, 0x73, 0xe8, 0x26,
+	0xa8, 0x98, 0x4c, 0x0b, 0x9d, 0x0a, 0x6e, 0xd9, 0x74, 0xe2, 0xd5, 0x4f, 0x0f, 0x92, 0x04, 0xdb,
+	0x24, 0xe9, 0x6c, 0x93, 0x04, 0x9e, 0x95, 0xa4, 0xbb, 0x2e, 0xc9, 0x93, 0x22, 0xf5, 0xd6, 0x2a,
+	0x3e, 0x86, 0x20, 0x46, 0x55, 0x08, 0xae, 0xec, 0xdd, 0x4c, 0x24, 0xae, 0xdb, 0x9a, 0xb1, 0xb5,
+	0x0d, 0x5f, 0x89, 0xaa, 0xcc, 0xb4, 0xd5, 0xaa, 0x17, 0x7b, 0x8f, 0x9c, 0xc2, 0x11, 0x4a, 0xe9,
+	0xe5, 0x32, 0xe6, 0xc5, 0xbf, 0x5d, 0x68, 0x8f, 0x24, 0xa2, 0x46, 0x49, 0x06, 0xf0, 0xe9, 0x0c,
+	0x79, 0x32, 0x97, 0x94, 0x2b, 0xca, 0x2c, 0x45, 0x18, 0x98, 0x59, 0xb4, 0xd3, 0xd7, 0x3f, 0xb6,
+	0x76, 0xf5, 0x6e, 0xf4, 0x09, 0x79, 0x0d, 0x30, 0x42, 0x3d, 0x64, 0xcc, 0x6a, 0xd9, 0xb3, 0xc7,
+	0x7e, 0x12, 0x37, 0xc1, 0xdf, 0x43, 0xf7, 0x11, 0xac, 0x48, 0xc7, 0x9e, 0x9b, 0xa1, 0xda, 0x84,
+	0xfe, 0x08, 0x27, 0x23, 0xd4, 0xab, 0x69, 0x38, 0xb4, 0x19, 0xe0, 0xe7, 0xd0, 0x97, 0x99, 0x60,
+	0xef, 0x2e, 0xef, 0x0d, 0xa4, 0x16, 0xfd, 0x06, 0x4e, 0x57, 0xd0, 0x6e, 0xea, 0xbb, 0x0e, 0x6f,
+	0x9d, 0xcd, 0x88, 0x57, 0x96, 0xe5, 0x54, 0x88, 0x6c, 0x7e, 0x57, 0x9f, 0xf7, 0x6b, 0x38, 0x1e,
+	0xa1, 0x9e, 0x50, 0xa5, 0xfd, 0xc5, 0xf5, 0x24, 0x8d, 0x1e, 0x55, 0xcf, 0xef, 0x52, 0xef, 0x0d,
+	0x10, 0x87, 0xbe, 0x49, 0x65, 0x8e, 0xc9, 0x1e, 0xf7, 0xbf, 0x84, 0xe6, 0x14, 0x51, 0xd6, 0x67,
+	0xfc, 0x2d, 0x04, 0xd7, 0x22, 0xc1, 0xdf, 0xf8, 0x8d, 0xd8, 0xc1, 0xac, 0xfb, 0x8b, 0xd2, 0x69,
+	0x4e, 0x35, 0xfe, 0x8a, 0xe8, 0x05, 0x73, 0x9b, 0xf0, 0xb9, 0x4a, 0x4f, 0x04, 0xa3, 0x3b, 0x15,
+	0x73, 0xda, 0x7a, 0x68, 0x6d, 0xdd, 0xbe, 0x81, 0xf6, 0x08, 0xf5, 0x78, 0x3e, 0xb9, 0xaa, 0x85,
+	0xfd, 0x00, 0x9d, 0x11, 0xea, 0x59, 0x59, 0x14, 0xd9, 0xfd, 0x2e, 0x4d, 0x2f, 0xa0, 0x67, 0x3a,
+	0xb2, 0xda, 0xca, 0xe4, 0xcc, 0xc1, 0x57, 0xb6, 0xf4, 0x56, 0x6e, 0x31, 0x2a, 0x91, 0xdd, 0xa2,
+	0xdd, 0xf4, 0x9e, 0x1b, 0xcd, 0xb7, 0xc8, 0x30, 0x80, 0xe3, 0x18, 0x6f, 0x51, 0x2a, 0x9c, 0x08,
+	0xf1, 0xae, 0x2c, 0x76, 0xa5, 0xf3, 0x9d, 0x4d, 0x7d, 0xc8, 0xd9, 0x52, 0xc8, 0x3d, 0x38, 0x4e,
+	0xcb, 0xc5, 0xef, 0xb8, 0x07, 0xc7, 0x33, 0xdf, 0xbc, 0x1e, 0x62, 0x2a, 0xb2, 0x23, 0xc6, 0xb5,
+	0xb1, 0x89, 0x99, 0x69, 0xaa, 0xcb, 0xfa, 0x0a, 0xba, 0xc6, 0x34, 0xe0, 0x18, 0xff, 0x42, 0xa6,
+	0x31, 0xd9, 0x55, 0xf3, 0x0b, 0x78, 0x31, 0x4b, 0xf3, 0x32, 0xa3, 0x1a, 0xf7, 0xde, 0x34, 0x6f,
+	0xe1, 0xec, 0x4a, 0xe2, 0x5a, 0xc4, 0xb1, 0xef, 0x42, 0xf7, 0x7b, 0xb1, 0x19, 0x34, 0x84, 0x2f,
+	0x5c, 0x50, 0x35, 0x64, 0xab, 0xc1, 0x2f, 0x2c, 0xfa, 0xe9, 0x4f, 0xce, 0x36, 0x76, 0x67, 0x3f,
+	0xa3, 0xd9, 0xa8, 0x7b, 0x67, 0xfa, 0x13, 0x9c, 0xae, 0xed, 0x50, 0x55, 0x1f, 0x30, 0x78, 0x5c,
+	0x48, 0x73, 0xcc, 0x0b, 0x23, 0x4b, 0x9d, 0x7c, 0x8b, 0x96, 0xfd, 0x63, 0xf4, 0xf6, 0xff, 0x01,
+	0x00, 0xd4, 0xc5, 0x86, 0xf2, 0x25, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -331,6 +719,25 @@ type GreeterClient interface {
 	GetConfirmedHeight(ctx context.Context, in *Null, opts ...grpc.CallOption) (*Response, error)
 	Peers(ctx context.Context, in *Null, opts ...grpc.CallOption) (*Response, error)
 	NodeInfo(ctx context.Context, in *Null, opts ...grpc.CallOption) (*Response, error)
+	EstimateFee(ctx context.Context, in *TxSize, opts ...grpc.CallOption) (*Response, error)
+	GetLocalTxs(ctx context.Context, in *Null, opts ...grpc.CallOption) (*Response, error)
+	GetLocalTx(ctx context.Context, in *Hash, opts ...grpc.CallOption) (*Response, error)
+	GetHTLC(ctx context.Context, in *Hash, opts ...grpc.CallOption) (*Response, error)
+	GetSupply(ctx context.Context, in *Address, opts ...grpc.CallOption) (*Response, error)
+	GetAllowance(ctx context.Context, in *AllowanceReq, opts ...grpc.CallOption) (*Response, error)
+	ResolveName(ctx context.Context, in *Name, opts ...grpc.CallOption) (*Response, error)
+	ReverseLookup(ctx context.Context, in *Address, opts ...grpc.CallOption) (*Response, error)
+	GetAnchor(ctx context.Context, in *Hash, opts ...grpc.CallOption) (*Response, error)
+	GetPubKey(ctx context.Context, in *Address, opts ...grpc.CallOption) (*Response, error)
+	GetPoolAddressTxs(ctx context.Context, in *Address, opts ...grpc.CallOption) (*Response, error)
+	GetPoolStatus(ctx context.Context, in *Null, opts ...grpc.CallOption) (*Response, error)
+	GetPoolRejectedTxs(ctx context.Context, in *Null, opts ...grpc.CallOption) (*Response, error)
+	SimulateTransaction(ctx context.Context, in *Bytes, opts ...grpc.CallOption) (*Response, error)
+	CreateTransaction(ctx context.Context, in *TxParams, opts ...grpc.CallOption) (*Response, error)
+	CreateContractTransaction(ctx context.Context, in *ContractParams, opts ...grpc.CallOption) (*Response, error)
+	DecodeTransaction(ctx context.Context, in *Bytes, opts ...grpc.CallOption) (*Response, error)
+	SendTransactions(ctx context.Context, in *Bytes, opts ...grpc.CallOption) (*Response, error)
+	GetBlockTemplate(ctx context.Context, in *Null, opts ...grpc.CallOption) (*Response, error)
 }
 
 type greeterClient struct {
@@ -395,334 +802,923 @@ func (c *greeterClient) GetBlockByHeight(ctx context.Context, in *Height, opts .
 	return out, nil
 }
 
-func (c *greeterClient) GetPoolTxs(ctx context.Context, in *Null, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/rpc.Greeter/GetPoolTxs", in, out, opts...)
-	if err != nil {
+func (c *greeterClient) GetPoolTxs(ctx context.Context, in *Null, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/rpc.Greeter/GetPoolTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) GetLastHeight(ctx context.Context, in *Null, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/rpc.Greeter/GetLastHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) GetContract(ctx context.Context, in *Address, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/rpc.Greeter/GetContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) GetConfirmedHeight(ctx context.Context, in *Null, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/rpc.Greeter/GetConfirmedHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) Peers(ctx context.Context, in *Null, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/rpc.Greeter/Peers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) NodeInfo(ctx context.Context, in *Null, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/rpc.Greeter/NodeInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) EstimateFee(ctx context.Context, in *TxSize, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/rpc.Greeter/EstimateFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) GetLocalTxs(ctx context.Context, in *Null, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/rpc.Greeter/GetLocalTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) GetLocalTx(ctx context.Context, in *Hash, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/rpc.Greeter/GetLocalTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) GetHTLC(ctx context.Context, in *Hash, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/rpc.Greeter/GetHTLC", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) GetSupply(ctx context.Context, in *Address, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/rpc.Greeter/GetSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) GetAllowance(ctx context.Context, in *AllowanceReq, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/rpc.Greeter/GetAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) ResolveName(ctx context.Context, in *Name, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/rpc.Greeter/ResolveName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) ReverseLookup(ctx context.Context, in *Address, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/rpc.Greeter/ReverseLookup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) GetAnchor(ctx context.Context, in *Hash, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/rpc.Greeter/GetAnchor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) GetPubKey(ctx context.Context, in *Address, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/rpc.Greeter/GetPubKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) GetPoolAddressTxs(ctx context.Context, in *Address, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/rpc.Greeter/GetPoolAddressTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) GetPoolStatus(ctx context.Context, in *Null, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/rpc.Greeter/GetPoolStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) GetPoolRejectedTxs(ctx context.Context, in *Null, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/rpc.Greeter/GetPoolRejectedTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) SimulateTransaction(ctx context.Context, in *Bytes, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/rpc.Greeter/SimulateTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) CreateTransaction(ctx context.Context, in *TxParams, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/rpc.Greeter/CreateTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) CreateContractTransaction(ctx context.Context, in *ContractParams, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/rpc.Greeter/CreateContractTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) DecodeTransaction(ctx context.Context, in *Bytes, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/rpc.Greeter/DecodeTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) SendTransactions(ctx context.Context, in *Bytes, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/rpc.Greeter/SendTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *greeterClient) GetBlockTemplate(ctx context.Context, in *Null, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/rpc.Greeter/GetBlockTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GreeterServer is the server API for Greeter service.
+type GreeterServer interface {
+	// Sends a greeting
+	SendTransaction(context.Context, *Bytes) (*Response, error)
+	GetAccount(context.Context, *Address) (*Response, error)
+	GetAccounts(context.Context, *Null) (*Response, error)
+	GetTransaction(context.Context, *Hash) (*Response, error)
+	GetBlockByHash(context.Context, *Hash) (*Response, error)
+	GetBlockByHeight(context.Context, *Height) (*Response, error)
+	GetPoolTxs(context.Context, *Null) (*Response, error)
+	GetLastHeight(context.Context, *Null) (*Response, error)
+	GetContract(context.Context, *Address) (*Response, error)
+	GetConfirmedHeight(context.Context, *Null) (*Response, error)
+	Peers(context.Context, *Null) (*Response, error)
+	NodeInfo(context.Context, *Null) (*Response, error)
+	EstimateFee(context.Context, *TxSize) (*Response, error)
+	GetLocalTxs(context.Context, *Null) (*Response, error)
+	GetLocalTx(context.Context, *Hash) (*Response, error)
+	GetHTLC(context.Context, *Hash) (*Response, error)
+	GetSupply(context.Context, *Address) (*Response, error)
+	GetAllowance(context.Context, *AllowanceReq) (*Response, error)
+	ResolveName(context.Context, *Name) (*Response, error)
+	ReverseLookup(context.Context, *Address) (*Response, error)
+	GetAnchor(context.Context, *Hash) (*Response, error)
+	GetPubKey(context.Context, *Address) (*Response, error)
+	GetPoolAddressTxs(context.Context, *Address) (*Response, error)
+	GetPoolStatus(context.Context, *Null) (*Response, error)
+	GetPoolRejectedTxs(context.Context, *Null) (*Response, error)
+	SimulateTransaction(context.Context, *Bytes) (*Response, error)
+	CreateTransaction(context.Context, *TxParams) (*Response, error)
+	CreateContractTransaction(context.Context, *ContractParams) (*Response, error)
+	DecodeTransaction(context.Context, *Bytes) (*Response, error)
+	SendTransactions(context.Context, *Bytes) (*Response, error)
+	GetBlockTemplate(context.Context, *Null) (*Response, error)
+}
+
+// UnimplementedGreeterServer can be embedded to have forward compatible implementations.
+type UnimplementedGreeterServer struct {
+}
+
+func (*UnimplementedGreeterServer) SendTransaction(ctx context.Context, req *Bytes) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendTransaction not implemented")
+}
+func (*UnimplementedGreeterServer) GetAccount(ctx context.Context, req *Address) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccount not implemented")
+}
+func (*UnimplementedGreeterServer) GetAccounts(ctx context.Context, req *Null) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccounts not implemented")
+}
+func (*UnimplementedGreeterServer) GetTransaction(ctx context.Context, req *Hash) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
+}
+func (*UnimplementedGreeterServer) GetBlockByHash(ctx context.Context, req *Hash) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockByHash not implemented")
+}
+func (*UnimplementedGreeterServer) GetBlockByHeight(ctx context.Context, req *Height) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockByHeight not implemented")
+}
+func (*UnimplementedGreeterServer) GetPoolTxs(ctx context.Context, req *Null) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPoolTxs not implemented")
+}
+func (*UnimplementedGreeterServer) GetLastHeight(ctx context.Context, req *Null) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLastHeight not implemented")
+}
+func (*UnimplementedGreeterServer) GetContract(ctx context.Context, req *Address) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContract not implemented")
+}
+func (*UnimplementedGreeterServer) GetConfirmedHeight(ctx context.Context, req *Null) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfirmedHeight not implemented")
+}
+func (*UnimplementedGreeterServer) Peers(ctx context.Context, req *Null) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Peers not implemented")
+}
+func (*UnimplementedGreeterServer) NodeInfo(ctx context.Context, req *Null) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NodeInfo not implemented")
+}
+func (*UnimplementedGreeterServer) EstimateFee(ctx context.Context, req *TxSize) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateFee not implemented")
+}
+func (*UnimplementedGreeterServer) GetLocalTxs(ctx context.Context, req *Null) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLocalTxs not implemented")
+}
+func (*UnimplementedGreeterServer) GetLocalTx(ctx context.Context, req *Hash) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLocalTx not implemented")
+}
+func (*UnimplementedGreeterServer) GetHTLC(ctx context.Context, req *Hash) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHTLC not implemented")
+}
+func (*UnimplementedGreeterServer) GetSupply(ctx context.Context, req *Address) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSupply not implemented")
+}
+func (*UnimplementedGreeterServer) GetAllowance(ctx context.Context, req *AllowanceReq) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllowance not implemented")
+}
+func (*UnimplementedGreeterServer) ResolveName(ctx context.Context, req *Name) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveName not implemented")
+}
+func (*UnimplementedGreeterServer) ReverseLookup(ctx context.Context, req *Address) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseLookup not implemented")
+}
+func (*UnimplementedGreeterServer) GetAnchor(ctx context.Context, req *Hash) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnchor not implemented")
+}
+func (*UnimplementedGreeterServer) GetPubKey(ctx context.Context, req *Address) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPubKey not implemented")
+}
+func (*UnimplementedGreeterServer) GetPoolAddressTxs(ctx context.Context, req *Address) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPoolAddressTxs not implemented")
+}
+func (*UnimplementedGreeterServer) GetPoolStatus(ctx context.Context, req *Null) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPoolStatus not implemented")
+}
+func (*UnimplementedGreeterServer) GetPoolRejectedTxs(ctx context.Context, req *Null) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPoolRejectedTxs not implemented")
+}
+func (*UnimplementedGreeterServer) SimulateTransaction(ctx context.Context, req *Bytes) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateTransaction not implemented")
+}
+func (*UnimplementedGreeterServer) CreateTransaction(ctx context.Context, req *TxParams) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTransaction not implemented")
+}
+func (*UnimplementedGreeterServer) CreateContractTransaction(ctx context.Context, req *ContractParams) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateContractTransaction not implemented")
+}
+func (*UnimplementedGreeterServer) DecodeTransaction(ctx context.Context, req *Bytes) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodeTransaction not implemented")
+}
+func (*UnimplementedGreeterServer) SendTransactions(ctx context.Context, req *Bytes) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendTransactions not implemented")
+}
+func (*UnimplementedGreeterServer) GetBlockTemplate(ctx context.Context, req *Null) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockTemplate not implemented")
+}
+
+func RegisterGreeterServer(s *grpc.Server, srv GreeterServer) {
+	s.RegisterService(&_Greeter_serviceDesc, srv)
+}
+
+func _Greeter_SendTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Bytes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).SendTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Greeter/SendTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).SendTransaction(ctx, req.(*Bytes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Address)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).GetAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Greeter/GetAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).GetAccount(ctx, req.(*Address))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_GetAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Null)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).GetAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Greeter/GetAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).GetAccounts(ctx, req.(*Null))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Hash)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).GetTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Greeter/GetTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).GetTransaction(ctx, req.(*Hash))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_GetBlockByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Hash)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).GetBlockByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Greeter/GetBlockByHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).GetBlockByHash(ctx, req.(*Hash))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_GetBlockByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Height)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).GetBlockByHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Greeter/GetBlockByHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).GetBlockByHeight(ctx, req.(*Height))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_GetPoolTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Null)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).GetPoolTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Greeter/GetPoolTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).GetPoolTxs(ctx, req.(*Null))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_GetLastHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Null)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).GetLastHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Greeter/GetLastHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).GetLastHeight(ctx, req.(*Null))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_GetContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Address)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).GetContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Greeter/GetContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).GetContract(ctx, req.(*Address))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_GetConfirmedHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Null)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).GetConfirmedHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Greeter/GetConfirmedHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).GetConfirmedHeight(ctx, req.(*Null))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_Peers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Null)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).Peers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Greeter/Peers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).Peers(ctx, req.(*Null))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_NodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Null)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).NodeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Greeter/NodeInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).NodeInfo(ctx, req.(*Null))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_EstimateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxSize)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).EstimateFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Greeter/EstimateFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).EstimateFee(ctx, req.(*TxSize))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Greeter_GetLocalTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Null)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GreeterServer).GetLocalTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Greeter/GetLocalTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).GetLocalTxs(ctx, req.(*Null))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *greeterClient) GetLastHeight(ctx context.Context, in *Null, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/rpc.Greeter/GetLastHeight", in, out, opts...)
-	if err != nil {
+func _Greeter_GetLocalTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Hash)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GreeterServer).GetLocalTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Greeter/GetLocalTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).GetLocalTx(ctx, req.(*Hash))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *greeterClient) GetContract(ctx context.Context, in *Address, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/rpc.Greeter/GetContract", in, out, opts...)
-	if err != nil {
+func _Greeter_GetHTLC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Hash)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GreeterServer).GetHTLC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Greeter/GetHTLC",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).GetHTLC(ctx, req.(*Hash))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *greeterClient) GetConfirmedHeight(ctx context.Context, in *Null, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/rpc.Greeter/GetConfirmedHeight", in, out, opts...)
-	if err != nil {
+func _Greeter_GetSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Address)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GreeterServer).GetSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Greeter/GetSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).GetSupply(ctx, req.(*Address))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *greeterClient) Peers(ctx context.Context, in *Null, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/rpc.Greeter/Peers", in, out, opts...)
-	if err != nil {
+func _Greeter_GetAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllowanceReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GreeterServer).GetAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Greeter/GetAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).GetAllowance(ctx, req.(*AllowanceReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *greeterClient) NodeInfo(ctx context.Context, in *Null, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/rpc.Greeter/NodeInfo", in, out, opts...)
-	if err != nil {
+func _Greeter_ResolveName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Name)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// GreeterServer is the server API for Greeter service.
-type GreeterServer interface {
-	// Sends a greeting
-	SendTransaction(context.Context, *Bytes) (*Response, error)
-	GetAccount(context.Context, *Address) (*Response, error)
-	GetAccounts(context.Context, *Null) (*Response, error)
-	GetTransaction(context.Context, *Hash) (*Response, error)
-	GetBlockByHash(context.Context, *Hash) (*Response, error)
-	GetBlockByHeight(context.Context, *Height) (*Response, error)
-	GetPoolTxs(context.Context, *Null) (*Response, error)
-	GetLastHeight(context.Context, *Null) (*Response, error)
-	GetContract(context.Context, *Address) (*Response, error)
-	GetConfirmedHeight(context.Context, *Null) (*Response, error)
-	Peers(context.Context, *Null) (*Response, error)
-	NodeInfo(context.Context, *Null) (*Response, error)
-}
-
-// UnimplementedGreeterServer can be embedded to have forward compatible implementations.
-type UnimplementedGreeterServer struct {
-}
-
-func (*UnimplementedGreeterServer) SendTransaction(ctx context.Context, req *Bytes) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendTransaction not implemented")
-}
-func (*UnimplementedGreeterServer) GetAccount(ctx context.Context, req *Address) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAccount not implemented")
-}
-func (*UnimplementedGreeterServer) GetAccounts(ctx context.Context, req *Null) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAccounts not implemented")
-}
-func (*UnimplementedGreeterServer) GetTransaction(ctx context.Context, req *Hash) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
-}
-func (*UnimplementedGreeterServer) GetBlockByHash(ctx context.Context, req *Hash) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBlockByHash not implemented")
-}
-func (*UnimplementedGreeterServer) GetBlockByHeight(ctx context.Context, req *Height) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBlockByHeight not implemented")
-}
-func (*UnimplementedGreeterServer) GetPoolTxs(ctx context.Context, req *Null) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPoolTxs not implemented")
-}
-func (*UnimplementedGreeterServer) GetLastHeight(ctx context.Context, req *Null) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLastHeight not implemented")
-}
-func (*UnimplementedGreeterServer) GetContract(ctx context.Context, req *Address) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetContract not implemented")
-}
-func (*UnimplementedGreeterServer) GetConfirmedHeight(ctx context.Context, req *Null) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetConfirmedHeight not implemented")
-}
-func (*UnimplementedGreeterServer) Peers(ctx context.Context, req *Null) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Peers not implemented")
-}
-func (*UnimplementedGreeterServer) NodeInfo(ctx context.Context, req *Null) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NodeInfo not implemented")
-}
-
-func RegisterGreeterServer(s *grpc.Server, srv GreeterServer) {
-	s.RegisterService(&_Greeter_serviceDesc, srv)
+	if interceptor == nil {
+		return srv.(GreeterServer).ResolveName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.Greeter/ResolveName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GreeterServer).ResolveName(ctx, req.(*Name))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Greeter_SendTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Bytes)
+func _Greeter_ReverseLookup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Address)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GreeterServer).SendTransaction(ctx, in)
+		return srv.(GreeterServer).ReverseLookup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpc.Greeter/SendTransaction",
+		FullMethod: "/rpc.Greeter/ReverseLookup",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GreeterServer).SendTransaction(ctx, req.(*Bytes))
+		return srv.(GreeterServer).ReverseLookup(ctx, req.(*Address))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Greeter_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Address)
+func _Greeter_GetAnchor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Hash)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GreeterServer).GetAccount(ctx, in)
+		return srv.(GreeterServer).GetAnchor(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpc.Greeter/GetAccount",
+		FullMethod: "/rpc.Greeter/GetAnchor",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GreeterServer).GetAccount(ctx, req.(*Address))
+		return srv.(GreeterServer).GetAnchor(ctx, req.(*Hash))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Greeter_GetAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Null)
+func _Greeter_GetPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Address)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GreeterServer).GetAccounts(ctx, in)
+		return srv.(GreeterServer).GetPubKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpc.Greeter/GetAccounts",
+		FullMethod: "/rpc.Greeter/GetPubKey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GreeterServer).GetAccounts(ctx, req.(*Null))
+		return srv.(GreeterServer).GetPubKey(ctx, req.(*Address))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Greeter_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Hash)
+func _Greeter_GetPoolAddressTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Address)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GreeterServer).GetTransaction(ctx, in)
+		return srv.(GreeterServer).GetPoolAddressTxs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpc.Greeter/GetTransaction",
+		FullMethod: "/rpc.Greeter/GetPoolAddressTxs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GreeterServer).GetTransaction(ctx, req.(*Hash))
+		return srv.(GreeterServer).GetPoolAddressTxs(ctx, req.(*Address))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Greeter_GetBlockByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Hash)
+func _Greeter_GetPoolStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Null)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GreeterServer).GetBlockByHash(ctx, in)
+		return srv.(GreeterServer).GetPoolStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpc.Greeter/GetBlockByHash",
+		FullMethod: "/rpc.Greeter/GetPoolStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GreeterServer).GetBlockByHash(ctx, req.(*Hash))
+		return srv.(GreeterServer).GetPoolStatus(ctx, req.(*Null))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Greeter_GetBlockByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Height)
+func _Greeter_GetPoolRejectedTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Null)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GreeterServer).GetBlockByHeight(ctx, in)
+		return srv.(GreeterServer).GetPoolRejectedTxs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpc.Greeter/GetBlockByHeight",
+		FullMethod: "/rpc.Greeter/GetPoolRejectedTxs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GreeterServer).GetBlockByHeight(ctx, req.(*Height))
+		return srv.(GreeterServer).GetPoolRejectedTxs(ctx, req.(*Null))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Greeter_GetPoolTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Null)
+func _Greeter_SimulateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Bytes)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GreeterServer).GetPoolTxs(ctx, in)
+		return srv.(GreeterServer).SimulateTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpc.Greeter/GetPoolTxs",
+		FullMethod: "/rpc.Greeter/SimulateTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GreeterServer).GetPoolTxs(ctx, req.(*Null))
+		return srv.(GreeterServer).SimulateTransaction(ctx, req.(*Bytes))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Greeter_GetLastHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Null)
+func _Greeter_CreateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxParams)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GreeterServer).GetLastHeight(ctx, in)
+		return srv.(GreeterServer).CreateTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpc.Greeter/GetLastHeight",
+		FullMethod: "/rpc.Greeter/CreateTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GreeterServer).GetLastHeight(ctx, req.(*Null))
+		return srv.(GreeterServer).CreateTransaction(ctx, req.(*TxParams))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Greeter_GetContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Address)
+func _Greeter_CreateContractTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractParams)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GreeterServer).GetContract(ctx, in)
+		return srv.(GreeterServer).CreateContractTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpc.Greeter/GetContract",
+		FullMethod: "/rpc.Greeter/CreateContractTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GreeterServer).GetContract(ctx, req.(*Address))
+		return srv.(GreeterServer).CreateContractTransaction(ctx, req.(*ContractParams))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Greeter_GetConfirmedHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Null)
+func _Greeter_DecodeTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Bytes)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GreeterServer).GetConfirmedHeight(ctx, in)
+		return srv.(GreeterServer).DecodeTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpc.Greeter/GetConfirmedHeight",
+		FullMethod: "/rpc.Greeter/DecodeTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GreeterServer).GetConfirmedHeight(ctx, req.(*Null))
+		return srv.(GreeterServer).DecodeTransaction(ctx, req.(*Bytes))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Greeter_Peers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Null)
+func _Greeter_SendTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Bytes)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GreeterServer).Peers(ctx, in)
+		return srv.(GreeterServer).SendTransactions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpc.Greeter/Peers",
+		FullMethod: "/rpc.Greeter/SendTransactions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GreeterServer).Peers(ctx, req.(*Null))
+		return srv.(GreeterServer).SendTransactions(ctx, req.(*Bytes))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Greeter_NodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Greeter_GetBlockTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Null)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GreeterServer).NodeInfo(ctx, in)
+		return srv.(GreeterServer).GetBlockTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpc.Greeter/NodeInfo",
+		FullMethod: "/rpc.Greeter/GetBlockTemplate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GreeterServer).NodeInfo(ctx, req.(*Null))
+		return srv.(GreeterServer).GetBlockTemplate(ctx, req.(*Null))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -779,6 +1775,82 @@ var _Greeter_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NodeInfo",
 			Handler:    _Greeter_NodeInfo_Handler,
 		},
+		{
+			MethodName: "EstimateFee",
+			Handler:    _Greeter_EstimateFee_Handler,
+		},
+		{
+			MethodName: "GetLocalTxs",
+			Handler:    _Greeter_GetLocalTxs_Handler,
+		},
+		{
+			MethodName: "GetLocalTx",
+			Handler:    _Greeter_GetLocalTx_Handler,
+		},
+		{
+			MethodName: "GetHTLC",
+			Handler:    _Greeter_GetHTLC_Handler,
+		},
+		{
+			MethodName: "GetSupply",
+			Handler:    _Greeter_GetSupply_Handler,
+		},
+		{
+			MethodName: "GetAllowance",
+			Handler:    _Greeter_GetAllowance_Handler,
+		},
+		{
+			MethodName: "ResolveName",
+			Handler:    _Greeter_ResolveName_Handler,
+		},
+		{
+			MethodName: "ReverseLookup",
+			Handler:    _Greeter_ReverseLookup_Handler,
+		},
+		{
+			MethodName: "GetAnchor",
+			Handler:    _Greeter_GetAnchor_Handler,
+		},
+		{
+			MethodName: "GetPubKey",
+			Handler:    _Greeter_GetPubKey_Handler,
+		},
+		{
+			MethodName: "GetPoolAddressTxs",
+			Handler:    _Greeter_GetPoolAddressTxs_Handler,
+		},
+		{
+			MethodName: "GetPoolStatus",
+			Handler:    _Greeter_GetPoolStatus_Handler,
+		},
+		{
+			MethodName: "GetPoolRejectedTxs",
+			Handler:    _Greeter_GetPoolRejectedTxs_Handler,
+		},
+		{
+			MethodName: "SimulateTransaction",
+			Handler:    _Greeter_SimulateTransaction_Handler,
+		},
+		{
+			MethodName: "CreateTransaction",
+			Handler:    _Greeter_CreateTransaction_Handler,
+		},
+		{
+			MethodName: "CreateContractTransaction",
+			Handler:    _Greeter_CreateContractTransaction_Handler,
+		},
+		{
+			MethodName: "DecodeTransaction",
+			Handler:    _Greeter_DecodeTransaction_Handler,
+		},
+		{
+			MethodName: "SendTransactions",
+			Handler:    _Greeter_SendTransactions_Handler,
+		},
+		{
+			MethodName: "GetBlockTemplate",
+			Handler:    _Greeter_GetBlockTemplate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc.proto",
@@ -1,6 +1,8 @@
 package rpc
 
 import (
+	"bytes"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -14,9 +16,11 @@ import (
 	log "github.com/uworldao/UWORLD/log/log15"
 	"github.com/uworldao/UWORLD/p2p"
 	"github.com/uworldao/UWORLD/param"
+	"github.com/uworldao/UWORLD/common/encode/rlp"
 	"github.com/uworldao/UWORLD/rpc/rpctypes"
 	"github.com/uworldao/UWORLD/services/reqmgr"
 	"github.com/uworldao/UWORLD/ut"
+	"github.com/uworldao/UWORLD/ut/transaction"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -32,6 +36,8 @@ type Server struct {
 	txPool        core.ITxPool
 	accountState  core.IAccountState
 	contractState core.IContractState
+	htlcState     core.IHTLCState
+	nameState     core.INameState
 	consensus     consensus.IConsensus
 	chain         core.IBlockChain
 	grpcServer    *grpc.Server
@@ -40,9 +46,9 @@ type Server struct {
 }
 
 func NewServer(config *config.RpcConfig, txPool core.ITxPool, state core.IAccountState, contractState core.IContractState,
-	consensus consensus.IConsensus, chain core.IBlockChain, peerManager p2p.IPeerManager, peers reqmgr.Peers) *Server {
+	htlcState core.IHTLCState, nameState core.INameState, consensus consensus.IConsensus, chain core.IBlockChain, peerManager p2p.IPeerManager, peers reqmgr.Peers) *Server {
 	return &Server{config: config, txPool: txPool, accountState: state, contractState: contractState,
-		consensus: consensus, chain: chain, peerManager: peerManager, peers: peers}
+		htlcState: htlcState, nameState: nameState, consensus: consensus, chain: chain, peerManager: peerManager, peers: peers}
 }
 
 func (rs *Server) Start() error {
@@ -119,6 +125,173 @@ func (rs *Server) SendTransaction(_ context.Context, req *Bytes) (*Response, err
 	return NewResponse(rpctypes.RpcSuccess, []byte(fmt.Sprintf("send transaction %s success", tx.Hash().String())), ""), nil
 }
 
+// Maximum number of transactions sent by SendTransactions at a time
+const maxSendTxs = 1000
+
+// Add a batch of transactions to the pool, each transaction is
+// verified independently and has a result in the order of the batch
+func (rs *Server) SendTransactions(_ context.Context, req *Bytes) (*Response, error) {
+	var rpcTxs []*coreTypes.RpcTransaction
+	if err := json.Unmarshal(req.Bytes, &rpcTxs); err != nil {
+		return NewResponse(rpctypes.RpcErrParam, nil, err.Error()), nil
+	}
+	if len(rpcTxs) > maxSendTxs {
+		return NewResponse(rpctypes.RpcErrParam, nil, fmt.Sprintf("no more than %d transactions can be sent at a time", maxSendTxs)), nil
+	}
+	results := make([]*rpctypes.SendTxResult, 0, len(rpcTxs))
+	for _, rpcTx := range rpcTxs {
+		result := &rpctypes.SendTxResult{}
+		if rpcTx != nil && rpcTx.TxHead != nil {
+			result.Hash = rpcTx.TxHead.TxHash
+		}
+		tx, err := coreTypes.TranslateRpcTxToTx(rpcTx)
+		if err == nil {
+			err = rs.txPool.Add(tx, false)
+		}
+		if err != nil {
+			result.Error = err.Error()
+		} else {
+			result.Success = true
+		}
+		results = append(results, result)
+	}
+	bytes, err := json.Marshal(results)
+	if err != nil {
+		return NewResponse(rpctypes.RpcErrMarshal, nil, err.Error()), nil
+	}
+	return NewResponse(rpctypes.RpcSuccess, bytes, ""), nil
+}
+
+// Run the checks and the state changes of the transaction against
+// copies of the accounts, the transaction is not added to the pool
+// or broadcast
+func (rs *Server) SimulateTransaction(_ context.Context, req *Bytes) (*Response, error) {
+	var rpcTx *coreTypes.RpcTransaction
+	if err := json.Unmarshal(req.Bytes, &rpcTx); err != nil {
+		return NewResponse(rpctypes.RpcErrParam, nil, err.Error()), nil
+	}
+	tx, err := coreTypes.TranslateRpcTxToTx(rpcTx)
+	if err != nil {
+		return NewResponse(rpctypes.RpcErrParam, nil, err.Error()), nil
+	}
+	simulation := rpctypes.TranslateSimulationToRpcSimulation(rs.txPool.Simulate(tx))
+	bytes, err := json.Marshal(simulation)
+	if err != nil {
+		return NewResponse(rpctypes.RpcErrMarshal, nil, err.Error()), nil
+	}
+	return NewResponse(rpctypes.RpcSuccess, bytes, ""), nil
+}
+
+// Build an unsigned transfer transaction, the nonce and the fees are
+// filled in if they are not given
+func (rs *Server) CreateTransaction(_ context.Context, req *TxParams) (*Response, error) {
+	if !ut.CheckUWDAddress(param.Net, req.From) {
+		return NewResponse(rpctypes.RpcErrParam, nil, fmt.Sprintf("%s address check failed", req.From)), nil
+	}
+	contract := req.Contract
+	if contract == "" {
+		contract = param.Token.String()
+	}
+	tx := transaction.NewTransaction(req.From, req.To, contract, req.Note, req.Amount, req.Nonce)
+	return rs.unsignedTxResponse(tx, req.Fees, req.Validuntil), nil
+}
+
+// Build an unsigned transaction that creates or increases a contract
+// coin. The contract address of a new coin is derived from the sender
+// and the abbr, the address of an existing coin can be given instead,
+// whose issuer may have been transferred.
+func (rs *Server) CreateContractTransaction(_ context.Context, req *ContractParams) (*Response, error) {
+	if !ut.CheckUWDAddress(param.Net, req.From) {
+		return NewResponse(rpctypes.RpcErrParam, nil, fmt.Sprintf("%s address check failed", req.From)), nil
+	}
+	if err := ut.CheckAbbr(req.Abbr); err != nil {
+		return NewResponse(rpctypes.RpcErrParam, nil, err.Error()), nil
+	}
+	contract := req.Contract
+	if contract == "" {
+		var err error
+		if contract, err = ut.GenerateContractAddress(param.Net, req.From, req.Abbr); err != nil {
+			return NewResponse(rpctypes.RpcErrParam, nil, err.Error()), nil
+		}
+	} else if !ut.IsValidContractAddress(param.Net, contract) {
+		return NewResponse(rpctypes.RpcErrParam, nil, fmt.Sprintf("%s contract address check failed", contract)), nil
+	}
+	tx := transaction.NewContract(req.From, req.To, contract, req.Note, req.Amount, req.Nonce, req.Name, req.Abbr, req.Increase, req.Description)
+	return rs.unsignedTxResponse(tx, req.Fees, req.Validuntil), nil
+}
+
+func (rs *Server) unsignedTxResponse(tx *coreTypes.Transaction, fees, validUntil uint64) *Response {
+	tx.TxHead.ValidUntil = validUntil
+	if tx.TxHead.Nonce == 0 {
+		tx.TxHead.Nonce = rs.nextNonce(tx.From())
+	}
+	if fees != 0 {
+		tx.TxHead.Fees = fees
+	} else if minFees := rs.chain.GetMinFees(); minFees > tx.TxHead.Fees {
+		tx.TxHead.Fees = minFees
+	}
+	if err := tx.SetHash(); err != nil {
+		return NewResponse(rpctypes.RpcErrParam, nil, err.Error())
+	}
+	if err := tx.VerifyUnsignedTx(); err != nil {
+		return NewResponse(rpctypes.RpcErrParam, nil, err.Error())
+	}
+	rpcTx, err := coreTypes.TranslateTxToRpcTx(tx)
+	if err != nil {
+		return NewResponse(rpctypes.RpcErrParam, nil, err.Error())
+	}
+	bytes, err := json.Marshal(rpcTx)
+	if err != nil {
+		return NewResponse(rpctypes.RpcErrMarshal, nil, err.Error())
+	}
+	return NewResponse(rpctypes.RpcSuccess, bytes, "")
+}
+
+// The next nonce of the address after the state and the prepared
+// transactions of the pool
+func (rs *Server) nextNonce(address hasharry.Address) uint64 {
+	addressTxs := rs.txPool.GetAddressTxs(address)
+	nonce := addressTxs.Nonce
+	if len(addressTxs.Prepared) != 0 {
+		if last := addressTxs.Prepared[len(addressTxs.Prepared)-1].GetNonce(); last > nonce {
+			nonce = last
+		}
+	}
+	return nonce + 1
+}
+
+// Decode the rlp encoded signed transaction and run the checks of the
+// pool against it, the transaction is not added to the pool or broadcast
+func (rs *Server) DecodeTransaction(_ context.Context, req *Bytes) (*Response, error) {
+	var rlpTx *coreTypes.RlpTransaction
+	if err := rlp.DecodeBytes(req.Bytes, &rlpTx); err != nil {
+		return NewResponse(rpctypes.RpcErrParam, nil, err.Error()), nil
+	}
+	tx := rlpTx.TranslateToTransaction()
+	if tx == nil {
+		return NewResponse(rpctypes.RpcErrParam, nil, "unknown transaction type"), nil
+	}
+	// The body is decoded without an error check, so it must encode
+	// back to the same bytes
+	if encoded, err := rlp.EncodeToBytes(tx.TranslateToRlpTransaction()); err != nil || !bytes.Equal(encoded, req.Bytes) {
+		return NewResponse(rpctypes.RpcErrParam, nil, "wrong transaction body"), nil
+	}
+	rpcTx, err := coreTypes.TranslateTxToRpcTx(tx)
+	if err != nil {
+		return NewResponse(rpctypes.RpcErrParam, nil, err.Error()), nil
+	}
+	decoded := &rpctypes.DecodedTx{Transaction: rpcTx, Valid: true}
+	if simulation := rs.txPool.Simulate(tx); simulation.Err != nil {
+		decoded.Valid = false
+		decoded.Error = simulation.Err.Error()
+	}
+	bytes, err := json.Marshal(decoded)
+	if err != nil {
+		return NewResponse(rpctypes.RpcErrMarshal, nil, err.Error()), nil
+	}
+	return NewResponse(rpctypes.RpcSuccess, bytes, ""), nil
+}
+
 func (rs *Server) GetAccount(_ context.Context, req *Address) (*Response, error) {
 	if !ut.CheckUWDAddress(param.Net, req.Address) {
 		return NewResponse(rpctypes.RpcErrParam, nil, fmt.Sprintf("%s address check failed", req.Address)), nil
@@ -210,6 +383,65 @@ func (rs *Server) GetPoolTxs(context.Context, *Null) (*Response, error) {
 	return NewResponse(rpctypes.RpcSuccess, bytes, ""), nil
 }
 
+// Get the transactions of an address in the pool, with the nonce
+// values missing before its future transactions
+func (rs *Server) GetPoolAddressTxs(_ context.Context, req *Address) (*Response, error) {
+	if !ut.CheckUWDAddress(param.Net, req.Address) {
+		return NewResponse(rpctypes.RpcErrParam, nil, fmt.Sprintf("%s address check failed", req.Address)), nil
+	}
+	addressTxs := rs.txPool.GetAddressTxs(hasharry.StringToAddress(req.Address))
+	rpcAddressTxs, err := rpctypes.TranslateAddressTxsToRpcAddressTxs(addressTxs)
+	if err != nil {
+		return NewResponse(rpctypes.RpcErrMarshal, nil, err.Error()), nil
+	}
+	bytes, err := json.Marshal(rpcAddressTxs)
+	if err != nil {
+		return NewResponse(rpctypes.RpcErrMarshal, nil, err.Error()), nil
+	}
+	return NewResponse(rpctypes.RpcSuccess, bytes, ""), nil
+}
+
+func (rs *Server) GetPoolStatus(context.Context, *Null) (*Response, error) {
+	status := rpctypes.TranslateTxPoolStatusToRpcTxPoolStatus(rs.txPool.GetStatus())
+	bytes, err := json.Marshal(status)
+	if err != nil {
+		return NewResponse(rpctypes.RpcErrMarshal, nil, err.Error()), nil
+	}
+	return NewResponse(rpctypes.RpcSuccess, bytes, ""), nil
+}
+
+// Get the transactions recently rejected or evicted by the pool
+func (rs *Server) GetPoolRejectedTxs(context.Context, *Null) (*Response, error) {
+	rejectedTxs := []*rpctypes.RejectedTx{}
+	for _, rejected := range rs.txPool.GetRejectedTxs() {
+		rejectedTx, err := rpctypes.TranslateRejectedTxToRpcRejectedTx(rejected)
+		if err != nil {
+			return NewResponse(rpctypes.RpcErrMarshal, nil, err.Error()), nil
+		}
+		rejectedTxs = append(rejectedTxs, rejectedTx)
+	}
+	bytes, err := json.Marshal(rejectedTxs)
+	if err != nil {
+		return NewResponse(rpctypes.RpcErrMarshal, nil, err.Error()), nil
+	}
+	return NewResponse(rpctypes.RpcSuccess, bytes, ""), nil
+}
+
+// Build the transactions of the next block without producing it, the
+// pool is not changed
+func (rs *Server) GetBlockTemplate(context.Context, *Null) (*Response, error) {
+	template := rs.txPool.GetBlockTemplate(param.MaxBlockTransactions, param.MaxBlockSize)
+	rpcTemplate, err := rpctypes.TranslateBlockTemplateToRpcBlockTemplate(template, param.MaxBlockSize)
+	if err != nil {
+		return NewResponse(rpctypes.RpcErrMarshal, nil, err.Error()), nil
+	}
+	bytes, err := json.Marshal(rpcTemplate)
+	if err != nil {
+		return NewResponse(rpctypes.RpcErrMarshal, nil, err.Error()), nil
+	}
+	return NewResponse(rpctypes.RpcSuccess, bytes, ""), nil
+}
+
 func (rs *Server) GetCandidates(context.Context, *Null) (*Response, error) {
 	candidates := rs.consensus.GetCandidates(rs.chain)
 	if candidates == nil || len(candidates) == 0 {
@@ -240,6 +472,70 @@ func (rs *Server) GetContract(ctx context.Context, req *Address) (*Response, err
 	return NewResponse(rpctypes.RpcSuccess, bytes, ""), nil
 }
 
+func (rs *Server) GetSupply(_ context.Context, req *Address) (*Response, error) {
+	var issued, burned, txFees, consumption uint64
+	if req.Address == param.Token.String() {
+		issued = coreTypes.CalIssued(rs.chain.GetLastHeight())
+		burned = rs.heldToken(param.BurnAddress)
+		txFees = rs.heldToken(param.FeeAddress)
+		consumption = rs.heldToken(param.EaterAddress)
+	} else if contract := rs.contractState.GetContract(req.Address); contract != nil {
+		issued = contract.Issued()
+		burned = contract.Burned
+	} else {
+		return NewResponse(rpctypes.RpcErrContract, nil, fmt.Sprintf("contract address %s is not exist", req.Address)), nil
+	}
+	fees := txFees + consumption
+	var circulating uint64
+	if issued > burned+fees {
+		circulating = issued - burned - fees
+	}
+	bytes, err := json.Marshal(&rpctypes.Supply{
+		Contract:    req.Address,
+		Issued:      coreTypes.Amount(issued).ToCoin(),
+		Burned:      coreTypes.Amount(burned).ToCoin(),
+		Fees:        coreTypes.Amount(fees).ToCoin(),
+		TxFees:      coreTypes.Amount(txFees).ToCoin(),
+		Consumption: coreTypes.Amount(consumption).ToCoin(),
+		Circulating: coreTypes.Amount(circulating).ToCoin(),
+	})
+	if err != nil {
+		return NewResponse(rpctypes.RpcErrMarshal, nil, err.Error()), nil
+	}
+	return NewResponse(rpctypes.RpcSuccess, bytes, ""), nil
+}
+
+// The UWD held by the address, including the amount received in the
+// blocks that are not confirmed yet
+func (rs *Server) heldToken(address hasharry.Address) uint64 {
+	account := rs.accountState.GetAccountState(address).(*coreTypes.Account)
+	return account.GetBalance(param.Token.String()) + account.GetLockedOut(param.Token.String())
+}
+
+func (rs *Server) GetAllowance(_ context.Context, req *AllowanceReq) (*Response, error) {
+	if !ut.CheckUWDAddress(param.Net, req.Owner) {
+		return NewResponse(rpctypes.RpcErrParam, nil, fmt.Sprintf("%s address check failed", req.Owner)), nil
+	}
+	if !ut.CheckUWDAddress(param.Net, req.Spender) {
+		return NewResponse(rpctypes.RpcErrParam, nil, fmt.Sprintf("%s address check failed", req.Spender)), nil
+	}
+	if !ut.IsValidContractAddress(param.Net, req.Contract) {
+		return NewResponse(rpctypes.RpcErrParam, nil, fmt.Sprintf("%s contract address check failed", req.Contract)), nil
+	}
+	account := rs.accountState.GetAccountState(hasharry.StringToAddress(req.Owner))
+	allowance := account.(*coreTypes.Account).GetAllowance(req.Spender, req.Contract)
+	bytes, err := json.Marshal(&rpctypes.Allowance{
+		Owner:     req.Owner,
+		Spender:   req.Spender,
+		Contract:  req.Contract,
+		Allowance: coreTypes.Amount(allowance).ToCoin(),
+	})
+	if err != nil {
+		return NewResponse(rpctypes.RpcErrMarshal, nil, err.Error()), nil
+	}
+	return NewResponse(rpctypes.RpcSuccess, bytes, ""), nil
+}
+
 func (rs *Server) GetConfirmedHeight(context.Context, *Null) (*Response, error) {
 	height := rs.chain.GetConfirmedHeight()
 	sHeight := strconv.FormatUint(height, 10)
@@ -262,6 +558,148 @@ func NewResponse(code int32, result []byte, err string) *Response {
 	return &Response{Code: code, Result: result, Err: err}
 }
 
+// The size of a signed normal transaction without a note, the fee is
+// estimated for it if the size is not given
+const normalTxSize = 280
+
+// Get the minimum fee of the next block, the fee suggested by the pool
+// for a transaction of the size and the proof of work target of the
+// minimum fee
+func (rs *Server) EstimateFee(_ context.Context, req *TxSize) (*Response, error) {
+	size := req.Size
+	if size == 0 {
+		size = normalTxSize
+	}
+	minFees := rs.chain.GetMinFees()
+	fees := &rpctypes.Fees{
+		MinFees: minFees,
+		Fees:    rs.txPool.EstimateFees(size),
+		Size:    size,
+		PoWBits: coreTypes.TxPoWBits(minFees),
+	}
+	bytes, err := json.Marshal(fees)
+	if err != nil {
+		return NewResponse(rpctypes.RpcErrMarshal, nil, err.Error()), nil
+	}
+	return NewResponse(rpctypes.RpcSuccess, bytes, ""), nil
+}
+
+// Get the status of the transactions submitted by the local node
+func (rs *Server) GetLocalTxs(context.Context, *Null) (*Response, error) {
+	var localTxs []*rpctypes.LocalTx
+	for _, local := range rs.txPool.GetLocalTxs() {
+		localTx, err := rpctypes.TranslateLocalTxToRpcLocalTx(local)
+		if err != nil {
+			return NewResponse(rpctypes.RpcErrMarshal, nil, err.Error()), nil
+		}
+		localTxs = append(localTxs, localTx)
+	}
+	bytes, err := json.Marshal(localTxs)
+	if err != nil {
+		return NewResponse(rpctypes.RpcErrMarshal, nil, err.Error()), nil
+	}
+	return NewResponse(rpctypes.RpcSuccess, bytes, ""), nil
+}
+
+// Get the status of a transaction submitted by the local node
+func (rs *Server) GetLocalTx(_ context.Context, req *Hash) (*Response, error) {
+	hash, err := hasharry.StringToHash(req.Hash)
+	if err != nil {
+		return NewResponse(rpctypes.RpcErrParam, nil, "hash error"), nil
+	}
+	local, err := rs.txPool.GetLocalTx(hash)
+	if err != nil {
+		return NewResponse(rpctypes.RpcErrTxPool, nil, err.Error()), nil
+	}
+	localTx, err := rpctypes.TranslateLocalTxToRpcLocalTx(local)
+	if err != nil {
+		return NewResponse(rpctypes.RpcErrMarshal, nil, err.Error()), nil
+	}
+	bytes, err := json.Marshal(localTx)
+	if err != nil {
+		return NewResponse(rpctypes.RpcErrMarshal, nil, err.Error()), nil
+	}
+	return NewResponse(rpctypes.RpcSuccess, bytes, ""), nil
+}
+
+func (rs *Server) GetHTLC(_ context.Context, req *Hash) (*Response, error) {
+	id, err := hasharry.StringToHash(req.Hash)
+	if err != nil {
+		return NewResponse(rpctypes.RpcErrParam, nil, "hash error"), nil
+	}
+	htlc := rs.htlcState.GetHTLC(id)
+	if htlc == nil {
+		return NewResponse(rpctypes.RpcErrHTLC, nil, fmt.Sprintf("htlc %s is not exist", req.Hash)), nil
+	}
+	bytes, err := json.Marshal(coreTypes.TranslateHTLCToRpcHTLC(htlc))
+	if err != nil {
+		return NewResponse(rpctypes.RpcErrMarshal, nil, err.Error()), nil
+	}
+	return NewResponse(rpctypes.RpcSuccess, bytes, ""), nil
+}
+
+func (rs *Server) ResolveName(_ context.Context, req *Name) (*Response, error) {
+	name, err := rs.nameState.ResolveName(req.Name, rs.chain.GetLastHeight())
+	if err != nil {
+		return NewResponse(rpctypes.RpcErrName, nil, fmt.Sprintf("name %s: %s", req.Name, err.Error())), nil
+	}
+	bytes, err := json.Marshal(coreTypes.TranslateNameToRpcName(name))
+	if err != nil {
+		return NewResponse(rpctypes.RpcErrMarshal, nil, err.Error()), nil
+	}
+	return NewResponse(rpctypes.RpcSuccess, bytes, ""), nil
+}
+
+func (rs *Server) ReverseLookup(_ context.Context, req *Address) (*Response, error) {
+	if !ut.CheckUWDAddress(param.Net, req.Address) {
+		return NewResponse(rpctypes.RpcErrParam, nil, fmt.Sprintf("%s address check failed", req.Address)), nil
+	}
+	names := rs.nameState.ReverseLookup(hasharry.StringToAddress(req.Address), rs.chain.GetLastHeight())
+	rpcNames := make([]*coreTypes.RpcName, 0)
+	for _, name := range names {
+		rpcNames = append(rpcNames, coreTypes.TranslateNameToRpcName(name))
+	}
+	bytes, err := json.Marshal(rpcNames)
+	if err != nil {
+		return NewResponse(rpctypes.RpcErrMarshal, nil, err.Error()), nil
+	}
+	return NewResponse(rpctypes.RpcSuccess, bytes, ""), nil
+}
+
+func (rs *Server) GetAnchor(_ context.Context, req *Hash) (*Response, error) {
+	digest, err := coreTypes.ParseDigest(req.Hash)
+	if err != nil {
+		return NewResponse(rpctypes.RpcErrParam, nil, err.Error()), nil
+	}
+	anchor, err := rs.chain.GetAnchor(digest)
+	if err != nil {
+		return NewResponse(rpctypes.RpcErrBlockChain, nil, err.Error()), nil
+	}
+	bytes, err := json.Marshal(coreTypes.TranslateAnchorToRpcAnchor(anchor))
+	if err != nil {
+		return NewResponse(rpctypes.RpcErrMarshal, nil, err.Error()), nil
+	}
+	return NewResponse(rpctypes.RpcSuccess, bytes, ""), nil
+}
+
+func (rs *Server) GetPubKey(_ context.Context, req *Address) (*Response, error) {
+	if !ut.CheckUWDAddress(param.Net, req.Address) {
+		return NewResponse(rpctypes.RpcErrParam, nil, fmt.Sprintf("%s address check failed", req.Address)), nil
+	}
+	pubKey, err := rs.chain.GetPubKey(hasharry.StringToAddress(req.Address))
+	if err != nil {
+		return NewResponse(rpctypes.RpcErrBlockChain, nil, err.Error()), nil
+	}
+	bytes, err := json.Marshal(&rpctypes.PubKey{
+		Address: req.Address,
+		PubKey:  hex.EncodeToString(pubKey),
+	})
+	if err != nil {
+		return NewResponse(rpctypes.RpcErrMarshal, nil, err.Error()), nil
+	}
+	return NewResponse(rpctypes.RpcSuccess, bytes, ""), nil
+}
+
 // Authenticate rpc users
 func (rs *Server) auth(ctx context.Context) error {
 	md, ok := metadata.FromIncomingContext(ctx)
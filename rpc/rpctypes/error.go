@@ -8,4 +8,6 @@ const (
 	RpcErrDPos
 	RpcErrParam
 	RpcErrContract
+	RpcErrHTLC
+	RpcErrName
 )
@@ -1,6 +1,9 @@
 package rpctypes
 
-import "github.com/uworldao/UWORLD/core/types"
+import (
+	"encoding/hex"
+	"github.com/uworldao/UWORLD/core/types"
+)
 
 type Account struct {
 	Address         string         `json:"address"`
@@ -10,6 +13,10 @@ type Account struct {
 	ConfirmedHeight uint64         `json:"confirmedheight"`
 	ConfirmedNonce  uint64         `json:"confirmednonce"`
 	ConfirmedTime   uint64         `json:"confirmedtime"`
+	TimeLocks       []*TimeLock    `json:"timelocks"`
+	Allowances      []*Allowance   `json:"allowances"`
+	// Public key bound by a key rotation, empty if the key was never rotated
+	PubKey string `json:"pubkey,omitempty"`
 }
 
 type CoinAccount struct {
@@ -17,6 +24,17 @@ type CoinAccount struct {
 	Balance   float64 `json:"balance"`
 	LockedOut float64 `json:"lockedout"`
 	LockedIn  float64 `json:"lockedin"`
+	// Amount of time-locked transfers that is not unlocked yet
+	TimeLocked float64 `json:"timelocked"`
+}
+
+// Unlock schedule of a time-locked amount
+type TimeLock struct {
+	Contract     string  `json:"contract"`
+	Amount       float64 `json:"amount"`
+	Height       uint64  `json:"height"`
+	UnlockHeight uint64  `json:"unlockheight"`
+	UnlockTime   uint64  `json:"unlocktime"`
 }
 
 func TranslateAccountToRpcAccount(account *types.Account) *Account {
@@ -27,6 +45,27 @@ func TranslateAccountToRpcAccount(account *types.Account) *Account {
 			LockedOut: types.Amount(coinAccount.LockedOut).ToCoin(),
 			LockedIn:  types.Amount(coinAccount.LockedIn).ToCoin(),
 			Balance:   types.Amount(coinAccount.Balance).ToCoin(),
+
+			TimeLocked: types.Amount(coinAccount.TimeLocked).ToCoin(),
+		})
+	}
+	timeLocks := []*TimeLock{}
+	for _, lock := range account.TimeLocks {
+		timeLocks = append(timeLocks, &TimeLock{
+			Contract:     lock.Contract,
+			Amount:       types.Amount(lock.Amount).ToCoin(),
+			Height:       lock.Height,
+			UnlockHeight: lock.UnlockHeight,
+			UnlockTime:   lock.UnlockTime,
+		})
+	}
+	allowances := []*Allowance{}
+	for _, allowance := range account.Allowances {
+		allowances = append(allowances, &Allowance{
+			Owner:     account.Address.String(),
+			Spender:   allowance.Spender,
+			Contract:  allowance.Contract,
+			Allowance: types.Amount(allowance.Amount).ToCoin(),
 		})
 	}
 	rpcAccount := &Account{
@@ -37,6 +76,9 @@ func TranslateAccountToRpcAccount(account *types.Account) *Account {
 		ConfirmedHeight: account.ConfirmedHeight,
 		ConfirmedNonce:  account.ConfirmedNonce,
 		ConfirmedTime:   account.ConfirmedTime,
+		TimeLocks:       timeLocks,
+		Allowances:      allowances,
+		PubKey:          hex.EncodeToString(account.PubKey),
 	}
 	return rpcAccount
 }
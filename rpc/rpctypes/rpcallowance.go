@@ -0,0 +1,9 @@
+package rpctypes
+
+// Amount that the spender can transfer from the owner
+type Allowance struct {
+	Owner     string  `json:"owner"`
+	Spender   string  `json:"spender"`
+	Contract  string  `json:"contract"`
+	Allowance float64 `json:"allowance"`
+}
@@ -0,0 +1,55 @@
+package rpctypes
+
+import (
+	"github.com/uworldao/UWORLD/core"
+)
+
+// Transactions selected for the next block
+type BlockTemplate struct {
+	Height      uint64        `json:"height"`
+	TxsCount    int           `json:"txscount"`
+	Size        uint64        `json:"size"`
+	MaxSize     uint64        `json:"maxsize"`
+	Fees        uint64        `json:"fees"`
+	Consumption uint64        `json:"consumption"`
+	Txs         []*TemplateTx `json:"txs"`
+	Failed      []*RejectedTx `json:"failed"`
+}
+
+type TemplateTx struct {
+	Hash  string `json:"hash"`
+	From  string `json:"from"`
+	Nonce uint64 `json:"nonce"`
+	Fees  uint64 `json:"fees"`
+	Size  uint64 `json:"size"`
+}
+
+func TranslateBlockTemplateToRpcBlockTemplate(template *core.BlockTemplate, maxSize uint64) (*BlockTemplate, error) {
+	rpcTemplate := &BlockTemplate{
+		Height:      template.Height,
+		TxsCount:    len(template.Txs),
+		Size:        template.Size,
+		MaxSize:     maxSize,
+		Fees:        template.Fees,
+		Consumption: template.Consumption,
+		Txs:         []*TemplateTx{},
+		Failed:      []*RejectedTx{},
+	}
+	for _, tx := range template.Txs {
+		rpcTemplate.Txs = append(rpcTemplate.Txs, &TemplateTx{
+			Hash:  tx.Hash().String(),
+			From:  tx.From().String(),
+			Nonce: tx.GetNonce(),
+			Fees:  tx.GetFees(),
+			Size:  tx.Size(),
+		})
+	}
+	for _, failed := range template.Failed {
+		rejectedTx, err := TranslateRejectedTxToRpcRejectedTx(failed)
+		if err != nil {
+			return nil, err
+		}
+		rpcTemplate.Failed = append(rpcTemplate.Failed, rejectedTx)
+	}
+	return rpcTemplate, nil
+}
@@ -0,0 +1,13 @@
+package rpctypes
+
+// Fee estimate of the next block
+type Fees struct {
+	// The minimum fee required by the block
+	MinFees uint64 `json:"minfees"`
+	// The fee suggested for a transaction of the size according to the
+	// fee rates of the transactions in the pool
+	Fees uint64 `json:"fees"`
+	Size uint64 `json:"size"`
+	// The leading zero bits of the proof of work that replaces the fees
+	PoWBits int `json:"powbits"`
+}
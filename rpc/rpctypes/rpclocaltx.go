@@ -0,0 +1,29 @@
+package rpctypes
+
+import (
+	"github.com/uworldao/UWORLD/core"
+	"github.com/uworldao/UWORLD/core/types"
+)
+
+// Status of a transaction submitted by the local node
+type LocalTx struct {
+	Hash        string                `json:"hash"`
+	Status      string                `json:"status"`
+	Reason      string                `json:"reason,omitempty"`
+	Height      uint64                `json:"height,omitempty"`
+	Transaction *types.RpcTransaction `json:"transaction"`
+}
+
+func TranslateLocalTxToRpcLocalTx(local *core.LocalTxStatus) (*LocalTx, error) {
+	rpcTx, err := types.TranslateTxToRpcTx(local.Tx.(*types.Transaction))
+	if err != nil {
+		return nil, err
+	}
+	return &LocalTx{
+		Hash:        local.Tx.Hash().String(),
+		Status:      local.Status,
+		Reason:      local.Reason,
+		Height:      local.Height,
+		Transaction: rpcTx,
+	}, nil
+}
@@ -0,0 +1,7 @@
+package rpctypes
+
+// Public key of the address known from its signatures on the chain
+type PubKey struct {
+	Address string `json:"address"`
+	PubKey  string `json:"pubkey"`
+}
@@ -0,0 +1,13 @@
+package rpctypes
+
+import (
+	"github.com/uworldao/UWORLD/core/types"
+)
+
+// Result of a decoded raw transaction
+type DecodedTx struct {
+	Transaction *types.RpcTransaction `json:"transaction"`
+	// Whether the transaction would be accepted by the pool
+	Valid bool   `json:"valid"`
+	Error string `json:"error,omitempty"`
+}
@@ -0,0 +1,8 @@
+package rpctypes
+
+// Result of a transaction in a batch sent by SendTransactions
+type SendTxResult struct {
+	Hash    string `json:"hash"`
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty"`
+}
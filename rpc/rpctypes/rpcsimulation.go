@@ -0,0 +1,36 @@
+package rpctypes
+
+import (
+	"github.com/uworldao/UWORLD/core"
+	"github.com/uworldao/UWORLD/core/types"
+)
+
+// Result of a simulated transaction
+type Simulation struct {
+	Hash string `json:"hash"`
+	// Whether the transaction would be accepted by the pool
+	Valid       bool   `json:"valid"`
+	Error       string `json:"error,omitempty"`
+	Fees        uint64 `json:"fees"`
+	Consumption uint64 `json:"consumption"`
+	// Accounts changed by the transaction, in the state after the
+	// transaction if it is valid
+	Accounts []*Account `json:"accounts"`
+}
+
+func TranslateSimulationToRpcSimulation(simulation *core.TxSimulation) *Simulation {
+	rpcSimulation := &Simulation{
+		Hash:        simulation.Tx.Hash().String(),
+		Valid:       simulation.Err == nil,
+		Fees:        simulation.Fees,
+		Consumption: simulation.Consumption,
+		Accounts:    []*Account{},
+	}
+	if simulation.Err != nil {
+		rpcSimulation.Error = simulation.Err.Error()
+	}
+	for _, account := range simulation.Accounts {
+		rpcSimulation.Accounts = append(rpcSimulation.Accounts, TranslateAccountToRpcAccount(account.(*types.Account)))
+	}
+	return rpcSimulation
+}
@@ -0,0 +1,18 @@
+package rpctypes
+
+// Supply of UWD or a contract coin
+type Supply struct {
+	Contract string `json:"contract"`
+	// Total amount issued, UWD includes the genesis coins and the coin base rewards
+	Issued float64 `json:"issued"`
+	// Total amount destroyed by burn transactions
+	Burned float64 `json:"burned"`
+	// UWD held by the fee address and the eater address, only for UWD,
+	// which is the sum of TxFees and Consumption
+	Fees float64 `json:"fees"`
+	// Transaction fees held by the fee address
+	TxFees float64 `json:"txfees"`
+	// Fees of publishing contracts and names consumed by the eater address
+	Consumption float64 `json:"consumption"`
+	Circulating float64 `json:"circulating"`
+}
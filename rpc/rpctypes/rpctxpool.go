@@ -0,0 +1,136 @@
+package rpctypes
+
+import (
+	"github.com/uworldao/UWORLD/core"
+	"github.com/uworldao/UWORLD/core/types"
+)
+
+// Transactions of an address in the transaction pool
+type AddressTxs struct {
+	Address     string                  `json:"address"`
+	Nonce       uint64                  `json:"nonce"`
+	PreparedTxs []*types.RpcTransaction `json:"preparedtxs"`
+	FutureTxs   []*types.RpcTransaction `json:"futuretxs"`
+	Gaps        []*NonceGap             `json:"gaps"`
+}
+
+// Nonce values from and to, both included, are missing
+type NonceGap struct {
+	From uint64 `json:"from"`
+	To   uint64 `json:"to"`
+}
+
+type TxPoolStatus struct {
+	TxsCount      int    `json:"txscount"`
+	PreparedCount int    `json:"preparedcount"`
+	FutureCount   int    `json:"futurecount"`
+	LocalCount    int    `json:"localcount"`
+	RejectedCount int    `json:"rejectedcount"`
+	Bytes         uint64 `json:"bytes"`
+	OldestHash    string `json:"oldesthash,omitempty"`
+	OldestTime    uint64 `json:"oldesttime,omitempty"`
+
+	Policy  *TxPoolPolicy  `json:"policy"`
+	Metrics *TxPoolMetrics `json:"metrics"`
+}
+
+// Limits of the transaction pool configured by the node
+type TxPoolPolicy struct {
+	Capacity   int    `json:"capacity"`
+	MaxBytes   uint64 `json:"maxbytes"`
+	AccountTxs int    `json:"accounttxs"`
+	LifeTime   int64  `json:"lifetime"`
+	Eviction   string `json:"eviction"`
+}
+
+// Counters of the transaction pool since the node started
+type TxPoolMetrics struct {
+	Added           uint64 `json:"added"`
+	Replaced        uint64 `json:"replaced"`
+	Expired         uint64 `json:"expired"`
+	EvictedFuture   uint64 `json:"evictedfuture"`
+	Evicted         uint64 `json:"evicted"`
+	RejectedFull    uint64 `json:"rejectedfull"`
+	RejectedAccount uint64 `json:"rejectedaccount"`
+}
+
+// Transaction rejected or evicted by the transaction pool
+type RejectedTx struct {
+	Hash        string                `json:"hash"`
+	Time        uint64                `json:"time"`
+	Reason      string                `json:"reason"`
+	Transaction *types.RpcTransaction `json:"transaction"`
+}
+
+func TranslateAddressTxsToRpcAddressTxs(addressTxs *core.AddressTxs) (*AddressTxs, error) {
+	rpcAddressTxs := &AddressTxs{
+		Address:     addressTxs.Address.String(),
+		Nonce:       addressTxs.Nonce,
+		PreparedTxs: []*types.RpcTransaction{},
+		FutureTxs:   []*types.RpcTransaction{},
+		Gaps:        []*NonceGap{},
+	}
+	for _, tx := range addressTxs.Prepared {
+		rpcTx, err := types.TranslateTxToRpcTx(tx.(*types.Transaction))
+		if err != nil {
+			return nil, err
+		}
+		rpcAddressTxs.PreparedTxs = append(rpcAddressTxs.PreparedTxs, rpcTx)
+	}
+	for _, tx := range addressTxs.Future {
+		rpcTx, err := types.TranslateTxToRpcTx(tx.(*types.Transaction))
+		if err != nil {
+			return nil, err
+		}
+		rpcAddressTxs.FutureTxs = append(rpcAddressTxs.FutureTxs, rpcTx)
+	}
+	for _, gap := range addressTxs.Gaps {
+		rpcAddressTxs.Gaps = append(rpcAddressTxs.Gaps, &NonceGap{From: gap.From, To: gap.To})
+	}
+	return rpcAddressTxs, nil
+}
+
+func TranslateTxPoolStatusToRpcTxPoolStatus(status *core.TxPoolStatus) *TxPoolStatus {
+	rpcStatus := &TxPoolStatus{
+		TxsCount:      status.Prepared + status.Future,
+		PreparedCount: status.Prepared,
+		FutureCount:   status.Future,
+		LocalCount:    status.Local,
+		RejectedCount: status.Rejected,
+		Bytes:         status.Bytes,
+		Policy: &TxPoolPolicy{
+			Capacity:   status.Capacity,
+			MaxBytes:   status.MaxBytes,
+			AccountTxs: status.AccountTxs,
+			LifeTime:   status.LifeTime,
+			Eviction:   status.Eviction,
+		},
+		Metrics: &TxPoolMetrics{
+			Added:           status.Added,
+			Replaced:        status.Replaced,
+			Expired:         status.Expired,
+			EvictedFuture:   status.EvictedFuture,
+			Evicted:         status.Evicted,
+			RejectedFull:    status.RejectedFull,
+			RejectedAccount: status.RejectedAccount,
+		},
+	}
+	if status.Oldest != nil {
+		rpcStatus.OldestHash = status.Oldest.Hash().String()
+		rpcStatus.OldestTime = status.Oldest.GetTime()
+	}
+	return rpcStatus
+}
+
+func TranslateRejectedTxToRpcRejectedTx(rejected *core.RejectedTx) (*RejectedTx, error) {
+	rpcTx, err := types.TranslateTxToRpcTx(rejected.Tx.(*types.Transaction))
+	if err != nil {
+		return nil, err
+	}
+	return &RejectedTx{
+		Hash:        rejected.Tx.Hash().String(),
+		Time:        rejected.Time,
+		Reason:      rejected.Reason,
+		Transaction: rpcTx,
+	}, nil
+}
@@ -17,6 +17,7 @@ type AccountState struct {
 	accountMutex    sync.RWMutex
 	contractMutex   sync.RWMutex
 	confirmedHeight uint64
+	confirmedTime   uint64
 }
 
 func NewAccountState(dataDir string) (*AccountState, error) {
@@ -77,7 +78,7 @@ func (cs *AccountState) UpdateFrom(tx types.ITransaction, blockHeight uint64) er
 	defer cs.accountMutex.Unlock()
 
 	fromAccount := cs.stateDb.GetAccountState(tx.From())
-	err := fromAccount.Update(cs.confirmedHeight)
+	err := fromAccount.Update(cs.confirmedHeight, cs.confirmedTime)
 	if err != nil {
 		return err
 	}
@@ -87,6 +88,18 @@ func (cs *AccountState) UpdateFrom(tx types.ITransaction, blockHeight uint64) er
 		return err
 	}
 
+	// The fees of a sponsored transaction are charged to the payer
+	if tx.IsSponsored() {
+		payerAccount := cs.stateDb.GetAccountState(tx.GetPayer())
+		if err := payerAccount.Update(cs.confirmedHeight, cs.confirmedTime); err != nil {
+			return err
+		}
+		if err := payerAccount.PayFees(tx, blockHeight); err != nil {
+			return err
+		}
+		cs.setAccountState(payerAccount)
+	}
+
 	cs.setAccountState(fromAccount)
 	return nil
 }
@@ -99,7 +112,7 @@ func (cs *AccountState) UpdateTo(tx types.ITransaction, blockHeight uint64) erro
 	var toAccount types.IAccount
 
 	toAccount = cs.stateDb.GetAccountState(tx.GetTxBody().ToAddress())
-	err := toAccount.Update(cs.confirmedHeight)
+	err := toAccount.Update(cs.confirmedHeight, cs.confirmedTime)
 	if err != nil {
 		return err
 	}
@@ -113,6 +126,43 @@ func (cs *AccountState) UpdateTo(tx types.ITransaction, blockHeight uint64) erro
 	return nil
 }
 
+// Update the account that receives an amount not transferred by a
+// transaction, such as the amount escrowed in an HTLC
+func (cs *AccountState) UpdateReceive(address, contract hasharry.Address, amount, blockHeight uint64) error {
+	cs.accountMutex.Lock()
+	defer cs.accountMutex.Unlock()
+
+	account := cs.stateDb.GetAccountState(address)
+	if err := account.Update(cs.confirmedHeight, cs.confirmedTime); err != nil {
+		return err
+	}
+	account.ReceiveChange(address, contract, amount, blockHeight)
+	cs.setAccountState(account)
+	return nil
+}
+
+// Update the owner's account of a transfer-from, the amount is
+// deducted from the owner's balance and the spender's allowance.
+func (cs *AccountState) UpdateOwner(tx types.ITransaction, blockHeight uint64) error {
+	txBody, ok := tx.GetTxBody().(*types.TransferFromBody)
+	if !ok {
+		return types.ErrTxBody
+	}
+
+	cs.accountMutex.Lock()
+	defer cs.accountMutex.Unlock()
+
+	account := cs.stateDb.GetAccountState(txBody.Owner)
+	if err := account.Update(cs.confirmedHeight, cs.confirmedTime); err != nil {
+		return err
+	}
+	if err := account.SpendAllowance(tx); err != nil {
+		return err
+	}
+	cs.setAccountState(account)
+	return nil
+}
+
 func (cs *AccountState) UpdateFees(fees, blockHeight uint64) error {
 	cs.accountMutex.Lock()
 	defer cs.accountMutex.Unlock()
@@ -120,7 +170,7 @@ func (cs *AccountState) UpdateFees(fees, blockHeight uint64) error {
 	var account types.IAccount
 
 	account = cs.stateDb.GetAccountState(param.FeeAddress)
-	err := account.Update(cs.confirmedHeight)
+	err := account.Update(cs.confirmedHeight, cs.confirmedTime)
 	if err != nil {
 		return err
 	}
@@ -140,7 +190,7 @@ func (cs *AccountState) UpdateConsumption(fees, blockHeight uint64) error {
 	var account types.IAccount
 
 	account = cs.stateDb.GetAccountState(param.EaterAddress)
-	err := account.Update(cs.confirmedHeight)
+	err := account.Update(cs.confirmedHeight, cs.confirmedTime)
 	if err != nil {
 		return err
 	}
@@ -149,20 +199,45 @@ func (cs *AccountState) UpdateConsumption(fees, blockHeight uint64) error {
 	return nil
 }
 
+// Add the UWD burned by the transaction to the burn address
+func (cs *AccountState) UpdateBurned(tx types.ITransaction, blockHeight uint64) error {
+	cs.accountMutex.Lock()
+	defer cs.accountMutex.Unlock()
+
+	account := cs.stateDb.GetAccountState(param.BurnAddress)
+	if err := account.Update(cs.confirmedHeight, cs.confirmedTime); err != nil {
+		return err
+	}
+	account.BurnedChange(tx, blockHeight)
+	cs.setAccountState(account)
+	return nil
+}
+
 // Update the locked balance of an account
 func (cs *AccountState) updateAccountLocked(stateKey hasharry.Address) types.IAccount {
 	account := cs.stateDb.GetAccountState(stateKey)
-	account.Update(cs.confirmedHeight)
+	account.Update(cs.confirmedHeight, cs.confirmedTime)
 	return account
 }
 
-func (cs *AccountState) UpdateConfirmedHeight(height uint64) {
+// Update the confirmed height and the time of the confirmed block
+func (cs *AccountState) UpdateConfirmedHeight(height, blockTime uint64) {
 	cs.confirmedHeight = height
+	cs.confirmedTime = blockTime
 }
 
 // Verify the status of the trading account
 func (cs *AccountState) VerifyState(tx types.ITransaction) error {
 	switch tx.GetTxType() {
+	case types.TransferFromTransaction:
+		if err := cs.verifyTxState(tx); err != nil {
+			return err
+		}
+		txBody, ok := tx.GetTxBody().(*types.TransferFromBody)
+		if !ok {
+			return types.ErrTxBody
+		}
+		return cs.GetAccountState(txBody.Owner).VerifyAllowance(tx)
 	default:
 		return cs.verifyTxState(tx)
 	}
@@ -174,7 +249,21 @@ func (cs *AccountState) verifyTxState(tx types.ITransaction) error {
 	}
 
 	account := cs.GetAccountState(tx.From())
-	return account.VerifyTxState(tx)
+	if !account.VerifySigner(tx.From(), tx.GetSignScript().PubKey) {
+		return types.ErrSigner
+	}
+	if err := account.VerifyTxState(tx); err != nil {
+		return err
+	}
+
+	if tx.IsSponsored() {
+		payerAccount := cs.GetAccountState(tx.GetPayer())
+		if !payerAccount.VerifySigner(tx.GetPayer(), tx.GetTxHead().PayerSignScript.PubKey) {
+			return types.ErrPayerSigner
+		}
+		return payerAccount.VerifyPayFees(tx)
+	}
+	return nil
 }
 
 func (cs *AccountState) StateTrieCommit() (hasharry.Hash, error) {
@@ -36,6 +36,15 @@ type Network interface {
 	// Send transactions to peer nodes
 	SendTransaction(stream *p2p.StreamCreator, tx types.ITransaction) error
 
+	// Send a batch of transactions to peer nodes
+	SendTransactions(stream *p2p.StreamCreator, txs types.Transactions) error
+
+	// Get the hashes of the pending transactions of peer nodes
+	GetPoolTxHashes(stream *p2p.StreamCreator) ([]hasharry.Hash, error)
+
+	// Get the pending transactions of the hashes from peer nodes
+	GetPoolTxs(stream *p2p.StreamCreator, hashes []hasharry.Hash) (types.Transactions, error)
+
 	// Remotely verify whether a block is consistent
 	ValidationBlockHash(stream *p2p.StreamCreator, header *types.Header) (bool, error)
 
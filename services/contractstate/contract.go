@@ -1,9 +1,13 @@
 package contractstate
 
 import (
+	"errors"
+	"fmt"
 	"github.com/uworldao/UWORLD/common/hasharry"
 	"github.com/uworldao/UWORLD/core/types"
 	"github.com/uworldao/UWORLD/database/contractdb"
+	"github.com/uworldao/UWORLD/param"
+	"github.com/uworldao/UWORLD/ut"
 	"sync"
 )
 
@@ -53,24 +57,68 @@ func (c *ContractState) UpdateConfirmedHeight(height uint64) {
 	c.confirmedHeight = height
 }
 
-// Verification contract
+// Verification contract. The transfers of contract coins must comply
+// with the policies of the contract. A new contract is created at the
+// address derived from the sender and the abbr, an existing one can
+// only be increased by its current issuer.
 func (c *ContractState) VerifyState(tx types.ITransaction) error {
 	c.contractMutex.RLock()
 	defer c.contractMutex.RUnlock()
 
-	if tx.GetTxType() != types.ContractTransaction {
+	contractAddr := tx.GetTxBody().GetContract()
+	switch tx.GetTxType() {
+	case types.ContractTransaction:
+	case types.ContractPolicyTransaction:
+		contract := c.contractDb.GetContractState(contractAddr.String())
+		if contract == nil {
+			return fmt.Errorf("contract address %s is not exist", contractAddr.String())
+		}
+		return contract.VerifyPolicy(tx)
+	case types.ContractMetadataTransaction, types.ContractIssuerTransaction:
+		contract := c.contractDb.GetContractState(contractAddr.String())
+		if contract == nil {
+			return fmt.Errorf("contract address %s is not exist", contractAddr.String())
+		}
+		return contract.VerifyUpdate(tx)
+	case types.BurnTransaction:
+		contract := c.contractDb.GetContractState(contractAddr.String())
+		if contract == nil {
+			if !contractAddr.IsEqual(param.Token) {
+				return fmt.Errorf("contract address %s is not exist", contractAddr.String())
+			}
+			return nil
+		}
+		return contract.VerifyTransfer(tx.From(), hasharry.Address{})
+	default:
+		from, to, ok := types.TransferParties(tx)
+		if !ok || contractAddr.IsEqual(param.Token) {
+			return nil
+		}
+		if contract := c.contractDb.GetContractState(contractAddr.String()); contract != nil {
+			return contract.VerifyTransfer(from, to)
+		}
 		return nil
 	}
-	contractAddr := tx.GetTxBody().GetContract()
 	contract := c.contractDb.GetContractState(contractAddr.String())
 	if contract != nil {
 		return contract.Verify(tx)
 	}
+	return verifyNewContract(tx)
+}
+
+// The address of a new contract must be derived from the sender and the abbr
+func verifyNewContract(tx types.ITransaction) error {
+	txBody := tx.GetTxBody()
+	if !ut.CheckContractAddress(param.Net, tx.From().String(), txBody.GetAbbr(), txBody.GetContract().String()) {
+		return errors.New("check contract address failed")
+	}
 	return nil
 }
 
-// Update contract status
-func (c *ContractState) UpdateContract(tx types.ITransaction, blockHeight uint64) {
+// Update contract status. An additional issuance is verified again
+// against the current state, so that several issuances in the same
+// block cannot exceed the total limit.
+func (c *ContractState) UpdateContract(tx types.ITransaction, blockHeight uint64) error {
 	c.contractMutex.Lock()
 	defer c.contractMutex.Unlock()
 
@@ -81,24 +129,89 @@ func (c *ContractState) UpdateContract(tx types.ITransaction, blockHeight uint64
 		Time:     tx.GetTime(),
 		Amount:   txBody.GetAmount(),
 		Receiver: txBody.ToAddress().String(),
+		Action:   types.RecordIssue,
 	}
 	contractAddr := txBody.GetContract()
 	contract := c.contractDb.GetContractState(contractAddr.String())
 	if contract != nil {
+		if err := contract.Verify(tx); err != nil {
+			return err
+		}
 		contract.AddContract(contractRecord)
 	} else {
+		if err := verifyNewContract(tx); err != nil {
+			return err
+		}
 		contract = &types.Contract{
 			Contract:       contractAddr.String(),
 			CoinName:       txBody.GetName(),
 			CoinAbbr:       txBody.GetAbbr(),
 			Description:    txBody.GetDescription(),
 			IncreaseSwitch: txBody.GetIncreaseSwitch(),
+			Issuer:         tx.From().String(),
+			Policy:         txBody.(*types.ContractBody).Policy,
 			Records: &types.RecordList{
 				contractRecord,
 			},
 		}
 	}
 	c.contractDb.SetContractState(contract)
+	return nil
+}
+
+// Add the amount of the burn transaction to the burned total of
+// the contract, the burned UWD is kept by the account state.
+func (c *ContractState) UpdateBurn(tx types.ITransaction, blockHeight uint64) {
+	c.contractMutex.Lock()
+	defer c.contractMutex.Unlock()
+
+	contractAddr := tx.GetTxBody().GetContract().String()
+	contract := c.contractDb.GetContractState(contractAddr)
+	if contract == nil {
+		return
+	}
+	contract.Burn(tx)
+	c.contractDb.SetContractState(contract)
+}
+
+// Apply the policy change of the issuer
+func (c *ContractState) UpdatePolicy(tx types.ITransaction, blockHeight uint64) error {
+	c.contractMutex.Lock()
+	defer c.contractMutex.Unlock()
+
+	contractAddr := tx.GetTxBody().GetContract().String()
+	contract := c.contractDb.GetContractState(contractAddr)
+	if contract == nil {
+		return fmt.Errorf("contract address %s is not exist", contractAddr)
+	}
+	if err := contract.VerifyPolicy(tx); err != nil {
+		return err
+	}
+	if err := contract.UpdatePolicy(tx); err != nil {
+		return err
+	}
+	c.contractDb.SetContractState(contract)
+	return nil
+}
+
+// Update the metadata or transfer the issuer rights of the contract
+func (c *ContractState) UpdateContractInfo(tx types.ITransaction, blockHeight uint64) error {
+	c.contractMutex.Lock()
+	defer c.contractMutex.Unlock()
+
+	contractAddr := tx.GetTxBody().GetContract().String()
+	contract := c.contractDb.GetContractState(contractAddr)
+	if contract == nil {
+		return fmt.Errorf("contract address %s is not exist", contractAddr)
+	}
+	if err := contract.VerifyUpdate(tx); err != nil {
+		return err
+	}
+	if err := contract.Update(tx, blockHeight); err != nil {
+		return err
+	}
+	c.contractDb.SetContractState(contract)
+	return nil
 }
 
 func (c *ContractState) Close() error {
@@ -0,0 +1,183 @@
+package contractstate
+
+import (
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/core/types"
+	"github.com/uworldao/UWORLD/crypto/ecc/secp256k1"
+	"github.com/uworldao/UWORLD/param"
+	"github.com/uworldao/UWORLD/ut"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func newTestAddress() hasharry.Address {
+	key, _ := secp256k1.GeneratePrivateKey()
+	return hasharry.StringToAddress(ut.GenerateUWDAddress(param.Net, key.PubKey()))
+}
+
+func newTestContractState(t *testing.T) (*ContractState, func()) {
+	dir, err := ioutil.TempDir("", "contractstate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cs, err := NewContractState(dir)
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	if err := cs.InitTrie(hasharry.Hash{}); err != nil {
+		t.Fatal(err)
+	}
+	return cs, func() {
+		cs.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func newTestTx(txType types.TransactionType, from hasharry.Address, nonce uint64, body types.ITransactionBody) types.ITransaction {
+	tx := &types.Transaction{
+		TxHead: &types.TransactionHead{TxType: txType, From: from, Nonce: nonce, Fees: param.TokenConsumption, Time: nonce},
+		TxBody: body,
+	}
+	tx.SetHash()
+	return tx
+}
+
+func newMintTx(contractAddr, from hasharry.Address, nonce uint64) types.ITransaction {
+	return newTestTx(types.ContractTransaction, from, nonce, &types.ContractBody{
+		Contract:       contractAddr,
+		To:             from,
+		Name:           "Test Coin",
+		Abbr:           "TC",
+		Amount:         param.AtomsPerCoin,
+		IncreaseSwitch: true,
+	})
+}
+
+// Verify and apply the transaction in the same way as the block
+func applyTestTx(cs *ContractState, tx types.ITransaction, height uint64) error {
+	if err := tx.GetTxBody().VerifyBody(tx.From()); err != nil {
+		return err
+	}
+	if err := cs.VerifyState(tx); err != nil {
+		return err
+	}
+	switch tx.GetTxType() {
+	case types.ContractMetadataTransaction, types.ContractIssuerTransaction:
+		return cs.UpdateContractInfo(tx, height)
+	}
+	return cs.UpdateContract(tx, height)
+}
+
+func TestContractState_MintAfterIssuerTransfer(t *testing.T) {
+	cs, closeState := newTestContractState(t)
+	defer closeState()
+
+	issuer, newIssuer := newTestAddress(), newTestAddress()
+	contractStr, _ := ut.GenerateContractAddress(param.Net, issuer.String(), "TC")
+	contractAddr := hasharry.StringToAddress(contractStr)
+
+	// Only the address derived from the sender can create the contract
+	if err := applyTestTx(cs, newMintTx(contractAddr, newIssuer, 1), 1); err == nil {
+		t.Fatal("the contract address is not derived from the sender")
+	}
+	if err := applyTestTx(cs, newMintTx(contractAddr, issuer, 1), 1); err != nil {
+		t.Fatal(err)
+	}
+	transfer := newTestTx(types.ContractIssuerTransaction, issuer, 2, &types.ContractIssuerBody{Contract: contractAddr, Issuer: newIssuer})
+	if err := applyTestTx(cs, transfer, 2); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := applyTestTx(cs, newMintTx(contractAddr, issuer, 3), 3); err == nil {
+		t.Fatal("the previous issuer can still issue coins")
+	}
+	if err := applyTestTx(cs, newMintTx(contractAddr, newIssuer, 2), 3); err != nil {
+		t.Fatal(err)
+	}
+	if issued := cs.GetContract(contractStr).Issued(); issued != 2*param.AtomsPerCoin {
+		t.Fatalf("wrong issued amount %d", issued)
+	}
+}
+
+// The contracts published before the issuer was recorded have no issuer,
+// the address the contract address is derived from controls them
+func TestContractState_UpdateLegacyContract(t *testing.T) {
+	cs, closeState := newTestContractState(t)
+	defer closeState()
+
+	issuer, newIssuer := newTestAddress(), newTestAddress()
+	contractStr, _ := ut.GenerateContractAddress(param.Net, issuer.String(), "TC")
+	contractAddr := hasharry.StringToAddress(contractStr)
+	cs.contractDb.SetContractState(&types.Contract{
+		Contract:       contractStr,
+		CoinName:       "Test Coin",
+		CoinAbbr:       "TC",
+		IncreaseSwitch: true,
+		Records:        &types.RecordList{},
+	})
+
+	metadata := func(from hasharry.Address, nonce uint64) types.ITransaction {
+		return newTestTx(types.ContractMetadataTransaction, from, nonce, &types.ContractMetadataBody{Contract: contractAddr, Description: "legacy"})
+	}
+	if err := applyTestTx(cs, metadata(newIssuer, 1), 1); err == nil {
+		t.Fatal("only the issuer can change the contract")
+	}
+	if err := applyTestTx(cs, metadata(issuer, 1), 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := applyTestTx(cs, newMintTx(contractAddr, issuer, 2), 2); err != nil {
+		t.Fatal(err)
+	}
+
+	transfer := newTestTx(types.ContractIssuerTransaction, issuer, 3, &types.ContractIssuerBody{Contract: contractAddr, Issuer: newIssuer})
+	if err := applyTestTx(cs, transfer, 3); err != nil {
+		t.Fatal(err)
+	}
+	contract := cs.GetContract(contractStr)
+	if contract.Issuer != newIssuer.String() || contract.Description != "legacy" {
+		t.Fatalf("wrong contract %+v", contract)
+	}
+	if err := applyTestTx(cs, newMintTx(contractAddr, issuer, 4), 4); err == nil {
+		t.Fatal("the previous issuer can still issue coins")
+	}
+	if err := applyTestTx(cs, newMintTx(contractAddr, newIssuer, 1), 4); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// The burned UWD is kept by the account state, no contract is created
+// at the token address
+func TestContractState_UpdateBurn(t *testing.T) {
+	cs, closeState := newTestContractState(t)
+	defer closeState()
+
+	issuer := newTestAddress()
+	contractStr, _ := ut.GenerateContractAddress(param.Net, issuer.String(), "TC")
+	contractAddr := hasharry.StringToAddress(contractStr)
+	if err := applyTestTx(cs, newMintTx(contractAddr, issuer, 1), 1); err != nil {
+		t.Fatal(err)
+	}
+
+	burn := func(contract hasharry.Address, nonce uint64) types.ITransaction {
+		return newTestTx(types.BurnTransaction, issuer, nonce, &types.BurnBody{Contract: contract, Amount: param.AtomsPerCoin})
+	}
+	token := burn(param.Token, 2)
+	if err := cs.VerifyState(token); err != nil {
+		t.Fatal(err)
+	}
+	cs.UpdateBurn(token, 2)
+	if cs.GetContract(param.Token.String()) != nil {
+		t.Fatal("the burned UWD should not create a contract")
+	}
+
+	coin := burn(contractAddr, 3)
+	if err := cs.VerifyState(coin); err != nil {
+		t.Fatal(err)
+	}
+	cs.UpdateBurn(coin, 3)
+	if burned := cs.GetContract(contractStr).Burned; burned != param.AtomsPerCoin {
+		t.Fatalf("wrong burned amount %d", burned)
+	}
+}
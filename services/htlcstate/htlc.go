@@ -0,0 +1,106 @@
+package htlcstate
+
+import (
+	"errors"
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/core/types"
+	"github.com/uworldao/UWORLD/database/htlcdb"
+	"sync"
+)
+
+const htlcState = "htlc_state"
+
+// HTLC status, used to store all hash time-locked contracts
+type HTLCState struct {
+	htlcDb    IHTLCStorage
+	htlcMutex sync.RWMutex
+}
+
+func NewHTLCState(dataDir string) (*HTLCState, error) {
+	storage := htlcdb.NewHTLCStorage(dataDir + "/" + htlcState)
+	err := storage.Open()
+	if err != nil {
+		return nil, err
+	}
+	return &HTLCState{
+		htlcDb: storage,
+	}, nil
+}
+
+// Initialize the HTLC state tree
+func (h *HTLCState) InitTrie(htlcRoot hasharry.Hash) error {
+	return h.htlcDb.InitTrie(htlcRoot)
+}
+
+func (h *HTLCState) RootHash() hasharry.Hash {
+	return h.htlcDb.RootHash()
+}
+
+// Commit HTLC status changes
+func (h *HTLCState) HTLCTrieCommit() (hasharry.Hash, error) {
+	return h.htlcDb.Commit()
+}
+
+func (h *HTLCState) GetHTLC(id hasharry.Hash) *types.HTLC {
+	h.htlcMutex.RLock()
+	defer h.htlcMutex.RUnlock()
+
+	return h.htlcDb.GetHTLC(id)
+}
+
+// Verify that the HTLC exists and can be settled by the
+// transaction in the block of the height
+func (h *HTLCState) VerifyState(tx types.ITransaction, height uint64) error {
+	h.htlcMutex.RLock()
+	defer h.htlcMutex.RUnlock()
+
+	switch tx.GetTxType() {
+	case types.HTLCLockTransaction:
+		if tx.GetTxBody().(*types.HTLCLockBody).ExpireHeight <= height {
+			return errors.New("the expire height must be greater than the block height")
+		}
+	case types.HTLCClaimTransaction, types.HTLCRefundTransaction:
+		id, _ := types.HTLCId(tx)
+		htlc := h.htlcDb.GetHTLC(id)
+		if htlc == nil {
+			return types.ErrNoHTLC
+		}
+		return htlc.Verify(tx, height)
+	}
+	return nil
+}
+
+// Create the HTLC of the lock transaction
+func (h *HTLCState) Lock(tx types.ITransaction, blockHeight uint64) error {
+	h.htlcMutex.Lock()
+	defer h.htlcMutex.Unlock()
+
+	if h.htlcDb.GetHTLC(tx.Hash()) != nil {
+		return errors.New("htlc already exists")
+	}
+	h.htlcDb.SetHTLC(types.NewHTLC(tx, blockHeight))
+	return nil
+}
+
+// Claim or refund the HTLC, return the HTLC to release the
+// escrowed amount
+func (h *HTLCState) Settle(tx types.ITransaction, blockHeight uint64) (*types.HTLC, error) {
+	h.htlcMutex.Lock()
+	defer h.htlcMutex.Unlock()
+
+	id, _ := types.HTLCId(tx)
+	htlc := h.htlcDb.GetHTLC(id)
+	if htlc == nil {
+		return nil, types.ErrNoHTLC
+	}
+	if err := htlc.Verify(tx, blockHeight); err != nil {
+		return nil, err
+	}
+	htlc.Settle(tx, blockHeight)
+	h.htlcDb.SetHTLC(htlc)
+	return htlc, nil
+}
+
+func (h *HTLCState) Close() error {
+	return h.htlcDb.Close()
+}
@@ -0,0 +1,16 @@
+package htlcstate
+
+import (
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/core/types"
+)
+
+// Implement storage as HTLC state
+type IHTLCStorage interface {
+	GetHTLC(id hasharry.Hash) *types.HTLC
+	SetHTLC(htlc *types.HTLC)
+	InitTrie(htlcRoot hasharry.Hash) error
+	RootHash() hasharry.Hash
+	Commit() (hasharry.Hash, error)
+	Close() error
+}
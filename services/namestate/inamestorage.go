@@ -0,0 +1,18 @@
+package namestate
+
+import (
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/core/types"
+)
+
+// Implement storage as name state
+type INameStorage interface {
+	GetName(name string) *types.Name
+	SetName(name *types.Name)
+	GetOwnerNames(owner hasharry.Address) []string
+	SetOwnerNames(owner hasharry.Address, names []string)
+	InitTrie(nameRoot hasharry.Hash) error
+	RootHash() hasharry.Hash
+	Commit() (hasharry.Hash, error)
+	Close() error
+}
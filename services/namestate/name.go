@@ -0,0 +1,156 @@
+package namestate
+
+import (
+	"fmt"
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/core/types"
+	"github.com/uworldao/UWORLD/database/namedb"
+	"sync"
+)
+
+const nameState = "name_state"
+
+// Name status, used to store the registered names and
+// the names of each owner
+type NameState struct {
+	nameDb    INameStorage
+	nameMutex sync.RWMutex
+}
+
+func NewNameState(dataDir string) (*NameState, error) {
+	storage := namedb.NewNameStorage(dataDir + "/" + nameState)
+	err := storage.Open()
+	if err != nil {
+		return nil, err
+	}
+	return &NameState{
+		nameDb: storage,
+	}, nil
+}
+
+// Initialize the name state tree
+func (n *NameState) InitTrie(nameRoot hasharry.Hash) error {
+	return n.nameDb.InitTrie(nameRoot)
+}
+
+func (n *NameState) RootHash() hasharry.Hash {
+	return n.nameDb.RootHash()
+}
+
+// Commit name status changes
+func (n *NameState) NameTrieCommit() (hasharry.Hash, error) {
+	return n.nameDb.Commit()
+}
+
+// Get the name that has not expired at the height
+func (n *NameState) ResolveName(name string, height uint64) (*types.Name, error) {
+	n.nameMutex.RLock()
+	defer n.nameMutex.RUnlock()
+
+	nameInfo := n.nameDb.GetName(name)
+	if nameInfo == nil {
+		return nil, types.ErrNoName
+	}
+	if nameInfo.IsExpired(height) {
+		return nil, types.ErrNameExpired
+	}
+	return nameInfo, nil
+}
+
+// Get the names of the owner that have not expired at the height
+func (n *NameState) ReverseLookup(owner hasharry.Address, height uint64) []*types.Name {
+	n.nameMutex.RLock()
+	defer n.nameMutex.RUnlock()
+
+	names := []*types.Name{}
+	for _, name := range n.nameDb.GetOwnerNames(owner) {
+		nameInfo := n.nameDb.GetName(name)
+		if nameInfo != nil && !nameInfo.IsExpired(height) {
+			names = append(names, nameInfo)
+		}
+	}
+	return names
+}
+
+// Verify the name transaction in the block of the height, a name
+// can be registered if it does not exist or has expired.
+func (n *NameState) VerifyState(tx types.ITransaction, height uint64) error {
+	n.nameMutex.RLock()
+	defer n.nameMutex.RUnlock()
+
+	return n.verifyState(tx, height)
+}
+
+func (n *NameState) verifyState(tx types.ITransaction, height uint64) error {
+	name, ok := types.TxName(tx)
+	if !ok {
+		return nil
+	}
+	nameInfo := n.nameDb.GetName(name)
+	if tx.GetTxType() == types.NameRegisterTransaction {
+		if nameInfo != nil && !nameInfo.IsExpired(height) {
+			return fmt.Errorf("name %s has been registered", name)
+		}
+		return nil
+	}
+	if nameInfo == nil {
+		return types.ErrNoName
+	}
+	return nameInfo.Verify(tx, height)
+}
+
+// Register, renew or transfer the name
+func (n *NameState) UpdateName(tx types.ITransaction, blockHeight uint64) error {
+	n.nameMutex.Lock()
+	defer n.nameMutex.Unlock()
+
+	if err := n.verifyState(tx, blockHeight); err != nil {
+		return err
+	}
+	switch body := tx.GetTxBody().(type) {
+	case *types.NameRegisterBody:
+		if old := n.nameDb.GetName(body.Name); old != nil {
+			n.removeOwnerName(old.Owner, old.Name)
+		}
+		nameInfo := types.NewName(tx, blockHeight)
+		n.nameDb.SetName(nameInfo)
+		n.addOwnerName(nameInfo.Owner, nameInfo.Name)
+	case *types.NameRenewBody:
+		nameInfo := n.nameDb.GetName(body.Name)
+		nameInfo.Renew()
+		n.nameDb.SetName(nameInfo)
+	case *types.NameTransferBody:
+		nameInfo := n.nameDb.GetName(body.Name)
+		n.removeOwnerName(nameInfo.Owner, nameInfo.Name)
+		nameInfo.Owner = body.To
+		n.nameDb.SetName(nameInfo)
+		n.addOwnerName(nameInfo.Owner, nameInfo.Name)
+	default:
+		return types.ErrTxBody
+	}
+	return nil
+}
+
+func (n *NameState) addOwnerName(owner hasharry.Address, name string) {
+	names := n.nameDb.GetOwnerNames(owner)
+	for _, ownerName := range names {
+		if ownerName == name {
+			return
+		}
+	}
+	n.nameDb.SetOwnerNames(owner, append(names, name))
+}
+
+func (n *NameState) removeOwnerName(owner hasharry.Address, name string) {
+	var names []string
+	for _, ownerName := range n.nameDb.GetOwnerNames(owner) {
+		if ownerName != name {
+			names = append(names, ownerName)
+		}
+	}
+	n.nameDb.SetOwnerNames(owner, names)
+}
+
+func (n *NameState) Close() error {
+	return n.nameDb.Close()
+}
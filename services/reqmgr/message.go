@@ -12,6 +12,7 @@ const (
 	DecodeError
 	JsonError
 	InternalError
+	TooManyRequests
 )
 
 const (
@@ -23,6 +24,9 @@ const (
 	getNodeInfo         Method = "getNodeInfo"
 	sendBlock           Method = "sendBlock"
 	sendTransaction     Method = "sendTransaction"
+	sendTransactions    Method = "sendTransactions"
+	getPoolTxHashes     Method = "getPoolTxHashes"
+	getPoolTxs          Method = "getPoolTxs"
 	validationBlockHash Method = "validationBlockHash"
 )
 
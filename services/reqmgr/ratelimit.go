@@ -0,0 +1,89 @@
+package reqmgr
+
+import (
+	"sync"
+	"time"
+)
+
+// Transactions accepted from a peer per second
+const peerTxRate = 20
+
+// Transactions a peer can send in a burst
+const peerTxBurst = 200
+
+// Pool synchronization requests accepted from a peer per second
+const poolSyncRate = 1
+
+// Pool synchronization requests a peer can send in a burst, enough
+// to fetch all the hashes it receives
+const poolSyncBurst = 50
+
+// Buckets of peers that have been idle for longer are released
+const idleBucketTime = 60 * 10
+
+// Token bucket of a peer, the tokens are refilled at the rate
+// up to the burst
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// Limit the rate of the requests from each peer
+type peerLimiter struct {
+	buckets map[string]*tokenBucket
+	rate    float64
+	burst   float64
+	cleaned time.Time
+	mutex   sync.Mutex
+}
+
+func newPeerLimiter(rate, burst float64) *peerLimiter {
+	return &peerLimiter{
+		buckets: make(map[string]*tokenBucket),
+		rate:    rate,
+		burst:   burst,
+		cleaned: time.Now(),
+	}
+}
+
+// Take a token from the bucket of the peer, false if the bucket is empty
+func (l *peerLimiter) Allow(peerId string) bool {
+	return l.AllowN(peerId, 1)
+}
+
+// Take n tokens from the bucket of the peer, false if the bucket
+// holds fewer tokens, in which case none are taken
+func (l *peerLimiter) AllowN(peerId string, n int) bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := time.Now()
+	l.clean(now)
+	bucket, ok := l.buckets[peerId]
+	if !ok {
+		bucket = &tokenBucket{tokens: l.burst, last: now}
+		l.buckets[peerId] = bucket
+	}
+	bucket.tokens += now.Sub(bucket.last).Seconds() * l.rate
+	if bucket.tokens > l.burst {
+		bucket.tokens = l.burst
+	}
+	bucket.last = now
+	if bucket.tokens < float64(n) {
+		return false
+	}
+	bucket.tokens -= float64(n)
+	return true
+}
+
+func (l *peerLimiter) clean(now time.Time) {
+	if now.Sub(l.cleaned) < time.Second*idleBucketTime {
+		return
+	}
+	for peerId, bucket := range l.buckets {
+		if now.Sub(bucket.last) >= time.Second*idleBucketTime {
+			delete(l.buckets, peerId)
+		}
+	}
+	l.cleaned = now
+}
@@ -0,0 +1,39 @@
+package reqmgr
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPeerLimiter_Allow(t *testing.T) {
+	limiter := newPeerLimiter(10, 5)
+	for i := 0; i < 5; i++ {
+		if !limiter.Allow("peer") {
+			t.Fatalf("request %d should be allowed in the burst", i+1)
+		}
+	}
+	if limiter.Allow("peer") {
+		t.Fatal("the bucket should be empty")
+	}
+	if !limiter.Allow("other") {
+		t.Fatal("each peer has its own bucket")
+	}
+
+	time.Sleep(time.Millisecond * 150)
+	if !limiter.Allow("peer") {
+		t.Fatal("the bucket should be refilled")
+	}
+}
+
+func TestPeerLimiter_AllowN(t *testing.T) {
+	limiter := newPeerLimiter(10, 5)
+	if !limiter.AllowN("peer", 4) {
+		t.Fatal("the batch is within the burst")
+	}
+	if limiter.AllowN("peer", 2) {
+		t.Fatal("the batch exceeds the tokens left")
+	}
+	if !limiter.Allow("peer") {
+		t.Fatal("a rejected batch should not take tokens")
+	}
+}
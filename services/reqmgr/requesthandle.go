@@ -2,11 +2,14 @@ package reqmgr
 
 import (
 	"errors"
+	"fmt"
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/uworldao/UWORLD/common/encode/rlp"
 	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/core"
 	"github.com/uworldao/UWORLD/core/types"
 	log "github.com/uworldao/UWORLD/log/log15"
+	"sort"
 	"strconv"
 )
 
@@ -15,6 +18,12 @@ var ErrorPeerClose = errors.New("peer is close")
 
 const maxGetBlockCount = 30
 
+// Maximum number of transactions sent in one request
+const maxTxBatchCount = 100
+
+// Maximum number of pending transaction hashes exchanged with a peer
+const maxPoolTxHashes = 4096
+
 type RWRequest struct {
 	request *Request
 	stream  network.Stream
@@ -162,18 +171,130 @@ func (rm *RequestManager) receivedBlock(request *RWRequest) (*Response, error) {
 	return response, nil
 }
 
+// Transactions from a peer that exceed its rate limit are dropped
+// before decoding
 func (rm *RequestManager) receivedTransaction(request *RWRequest) (*Response, error) {
 	var tx *types.RlpTransaction
 	var message string
 	var body []byte
 	code := Success
+	peerId := request.stream.Conn().RemotePeer().String()
+	if !rm.txLimiter.Allow(peerId) {
+		log.Warn("Peer sends transactions too fast", "peer", peerId)
+		return NewResponse(TooManyRequests, "too many transactions", body), nil
+	}
 	err := rlp.DecodeBytes(request.request.Body, &tx)
 	if err != nil {
 		code = InternalError
 		message = "failed to decode"
 	} else {
 		log.Info("RequestManager received transaction", "from", request.stream.Conn().RemoteMultiaddr().String())
-		rm.recTx <- tx.TranslateToTransaction()
+		rm.recTx <- &core.PeerTx{Tx: tx.TranslateToTransaction(), PeerId: peerId}
+	}
+	response := NewResponse(code, message, body)
+	return response, nil
+}
+
+// A batch of transactions is charged to the rate limit of the peer
+// as a whole, batches that exceed it are dropped before decoding
+func (rm *RequestManager) receivedTransactions(request *RWRequest) (*Response, error) {
+	var txs []*types.RlpTransaction
+	var message string
+	var body []byte
+	code := Success
+	peerId := request.stream.Conn().RemotePeer().String()
+	err := rlp.DecodeBytes(request.request.Body, &txs)
+	if err != nil {
+		code = InternalError
+		message = "failed to decode"
+	} else if len(txs) > maxTxBatchCount {
+		code = InternalError
+		message = fmt.Sprintf("no more than %d transactions can be sent at a time", maxTxBatchCount)
+	} else if !rm.txLimiter.AllowN(peerId, len(txs)) {
+		log.Warn("Peer sends transactions too fast", "peer", peerId)
+		return NewResponse(TooManyRequests, "too many transactions", body), nil
+	} else {
+		log.Info("RequestManager received transactions", "count", len(txs), "from", request.stream.Conn().RemoteMultiaddr().String())
+		for _, rlpTx := range txs {
+			if tx := rlpTx.TranslateToTransaction(); tx != nil {
+				rm.recTx <- &core.PeerTx{Tx: tx, PeerId: peerId}
+			}
+		}
+	}
+	response := NewResponse(code, message, body)
+	return response, nil
+}
+
+// Hashes of the pending transactions, the prepared transactions with
+// the highest fees come first
+func (rm *RequestManager) getPoolTxHashes(request *RWRequest) (*Response, error) {
+	var message string
+	var body []byte
+	code := Success
+	peerId := request.stream.Conn().RemotePeer().String()
+	if !rm.syncLimiter.Allow(peerId) {
+		return NewResponse(TooManyRequests, "too many requests", body), nil
+	}
+	if rm.txPool == nil {
+		return NewResponse(InternalError, "transaction pool is not ready", body), nil
+	}
+	preparedTxs, futureTxs := rm.txPool.GetAll()
+	sort.SliceStable(preparedTxs, func(i, j int) bool {
+		return preparedTxs[i].GetFees() > preparedTxs[j].GetFees()
+	})
+	hashes := make([]hasharry.Hash, 0)
+	for _, tx := range append(preparedTxs, futureTxs...) {
+		if len(hashes) >= maxPoolTxHashes {
+			break
+		}
+		hashes = append(hashes, tx.Hash())
+	}
+	body, err := rlp.EncodeToBytes(hashes)
+	if err != nil {
+		code = EncodeError
+		message = err.Error()
+	}
+	response := NewResponse(code, message, body)
+	return response, nil
+}
+
+// Pending transactions of the requested hashes, the hashes that are
+// not in the pool are skipped
+func (rm *RequestManager) getPoolTxs(request *RWRequest) (*Response, error) {
+	var hashes []hasharry.Hash
+	var message string
+	var body []byte
+	code := Success
+	peerId := request.stream.Conn().RemotePeer().String()
+	if !rm.syncLimiter.Allow(peerId) {
+		return NewResponse(TooManyRequests, "too many requests", body), nil
+	}
+	if rm.txPool == nil {
+		return NewResponse(InternalError, "transaction pool is not ready", body), nil
+	}
+	err := rlp.DecodeBytes(request.request.Body, &hashes)
+	if err != nil {
+		code = DecodeError
+		message = err.Error()
+	} else if len(hashes) > maxTxBatchCount {
+		code = InternalError
+		message = fmt.Sprintf("no more than %d transactions can be requested at a time", maxTxBatchCount)
+	} else {
+		requested := make(map[hasharry.Hash]bool)
+		for _, hash := range hashes {
+			requested[hash] = true
+		}
+		preparedTxs, futureTxs := rm.txPool.GetAll()
+		rlpTxs := make([]*types.RlpTransaction, 0)
+		for _, tx := range append(preparedTxs, futureTxs...) {
+			if requested[tx.Hash()] {
+				rlpTxs = append(rlpTxs, tx.TranslateToRlpTransaction())
+			}
+		}
+		if body, err = rlp.EncodeToBytes(rlpTxs); err != nil {
+			code = EncodeError
+			message = err.Error()
+		}
 	}
 	response := NewResponse(code, message, body)
 	return response, nil
@@ -16,7 +16,10 @@ type RequestManager struct {
 	blockChain  core.IBlockChain
 	requestChan chan *RWRequest
 	recBlkCh    chan *types.Block
-	recTx       chan types.ITransaction
+	recTx       chan *core.PeerTx
+	txPool      core.ITxPool
+	txLimiter   *peerLimiter
+	syncLimiter *peerLimiter
 	pool        sync.Pool
 	peers       Peers
 }
@@ -26,12 +29,14 @@ type Peers interface {
 	NodeInfo() *types.NodeInfo
 }
 
-func NewRequestManger(blockChain core.IBlockChain, recBlkCh chan *types.Block, recTx chan types.ITransaction, peers Peers) *RequestManager {
+func NewRequestManger(blockChain core.IBlockChain, recBlkCh chan *types.Block, recTx chan *core.PeerTx, peers Peers) *RequestManager {
 	return &RequestManager{
 		blockChain:  blockChain,
 		requestChan: make(chan *RWRequest, 100),
 		recBlkCh:    recBlkCh,
 		recTx:       recTx,
+		txLimiter:   newPeerLimiter(peerTxRate, peerTxBurst),
+		syncLimiter: newPeerLimiter(poolSyncRate, poolSyncBurst),
 		pool: sync.Pool{
 			New: func() interface{} {
 				return make([]byte, maxReadBytes)
@@ -41,6 +46,12 @@ func NewRequestManger(blockChain core.IBlockChain, recBlkCh chan *types.Block, r
 	}
 }
 
+// Set the transaction pool whose pending transactions are served
+// to the peers, the pool is created after the request manager
+func (rm *RequestManager) SetTxPool(txPool core.ITxPool) {
+	rm.txPool = txPool
+}
+
 // Listen for message requests
 func (rm *RequestManager) Start() {
 	for rwRequest := range rm.requestChan {
@@ -62,6 +73,12 @@ func (rm *RequestManager) Start() {
 			rf = rm.receivedBlock
 		case sendTransaction:
 			rf = rm.receivedTransaction
+		case sendTransactions:
+			rf = rm.receivedTransactions
+		case getPoolTxHashes:
+			rf = rm.getPoolTxHashes
+		case getPoolTxs:
+			rf = rm.getPoolTxs
 		case validationBlockHash:
 			rf = rm.validationBlockHash
 		default:
@@ -237,6 +237,147 @@ func (rm *RequestManager) SendTransaction(stream *p2p.StreamCreator, tx types.IT
 	return nil
 }
 
+// Send the transactions to the peer in batches, one stream per batch
+func (rm *RequestManager) SendTransactions(stream *p2p.StreamCreator, txs types.Transactions) error {
+	for start := 0; start < len(txs); start += maxTxBatchCount {
+		end := start + maxTxBatchCount
+		if end > len(txs) {
+			end = len(txs)
+		}
+		if err := rm.sendTxBatch(stream, txs[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (rm *RequestManager) sendTxBatch(stream *p2p.StreamCreator, txs types.Transactions) error {
+	s, err := stream.NewStreamFunc(stream.PeerId)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		s.Reset()
+		s.Close()
+	}()
+
+	log.Info("Send transactions to peer", "count", len(txs), "peer", s.Conn().RemoteMultiaddr().String())
+
+	s.SetDeadline(time.Unix(time.Now().Unix()+readTimeOut, 0))
+	rlpTxs := make([]*types.RlpTransaction, 0, len(txs))
+	for _, tx := range txs {
+		rlpTxs = append(rlpTxs, tx.TranslateToRlpTransaction())
+	}
+	bytes, err := rlp.EncodeToBytes(rlpTxs)
+	if err != nil {
+		return err
+	}
+	request := NewRequest(sendTransactions, bytes)
+	err = sendRequest(request, s)
+	if err != nil {
+		return err
+	}
+	response, err := rm.ReadResponse(s)
+	if response != nil && response.Code == Success {
+		return nil
+	} else {
+		if response != nil {
+			log.Warn("Failed Send Transactions to peer", "count", len(txs), "peer", s.Conn().RemoteMultiaddr().String(),
+				"error", response.Message)
+		} else if err != nil {
+			log.Warn("Failed Send Transactions to peer", "count", len(txs), "peer", s.Conn().RemoteMultiaddr().String(),
+				"error", err.Error())
+		}
+	}
+	return nil
+}
+
+// Get the hashes of the pending transactions of the peer, at most
+// maxPoolTxHashes are taken
+func (rm *RequestManager) GetPoolTxHashes(stream *p2p.StreamCreator) ([]hasharry.Hash, error) {
+	var hashes []hasharry.Hash
+	s, err := stream.NewStreamFunc(stream.PeerId)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		s.Reset()
+		s.Close()
+	}()
+
+	s.SetDeadline(time.Unix(time.Now().Unix()+readTimeOut, 0))
+	request := NewRequest(getPoolTxHashes, nil)
+	err = sendRequest(request, s)
+	if err != nil {
+		return nil, err
+	}
+	response, err := rm.ReadResponse(s)
+	if response != nil && response.Code == Success {
+		if err := rlp.DecodeBytes(response.Body, &hashes); err != nil {
+			return nil, err
+		}
+	} else if response != nil {
+		return nil, fmt.Errorf("peer error: %s", response.Message)
+	} else {
+		return nil, fmt.Errorf("peer error: %v", err)
+	}
+	if len(hashes) > maxPoolTxHashes {
+		hashes = hashes[:maxPoolTxHashes]
+	}
+	return hashes, nil
+}
+
+// Get the pending transactions of the hashes from the peer, the
+// transactions that were not requested are dropped
+func (rm *RequestManager) GetPoolTxs(stream *p2p.StreamCreator, hashes []hasharry.Hash) (types.Transactions, error) {
+	var rlpTxs []*types.RlpTransaction
+	if len(hashes) > maxTxBatchCount {
+		return nil, fmt.Errorf("no more than %d transactions can be requested at a time", maxTxBatchCount)
+	}
+	bytes, err := rlp.EncodeToBytes(hashes)
+	if err != nil {
+		return nil, err
+	}
+	s, err := stream.NewStreamFunc(stream.PeerId)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		s.Reset()
+		s.Close()
+	}()
+
+	s.SetDeadline(time.Unix(time.Now().Unix()+readTimeOut, 0))
+	request := NewRequest(getPoolTxs, bytes)
+	err = sendRequest(request, s)
+	if err != nil {
+		return nil, err
+	}
+	response, err := rm.ReadResponse(s)
+	if response != nil && response.Code == Success {
+		if err := rlp.DecodeBytes(response.Body, &rlpTxs); err != nil {
+			return nil, err
+		}
+	} else if response != nil {
+		return nil, fmt.Errorf("peer error: %s", response.Message)
+	} else {
+		return nil, fmt.Errorf("peer error: %v", err)
+	}
+	requested := make(map[hasharry.Hash]bool)
+	for _, hash := range hashes {
+		requested[hash] = true
+	}
+	txs := make(types.Transactions, 0)
+	for _, rlpTx := range rlpTxs {
+		tx := rlpTx.TranslateToTransaction()
+		if tx != nil && requested[tx.Hash()] {
+			delete(requested, tx.Hash())
+			txs = append(txs, tx)
+		}
+	}
+	return txs, nil
+}
+
 func (rm *RequestManager) ValidationBlockHash(stream *p2p.StreamCreator, header *types.Header) (bool, error) {
 	s, err := stream.NewStreamFunc(stream.PeerId)
 	if err != nil {
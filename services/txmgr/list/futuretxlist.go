@@ -8,12 +8,16 @@ import (
 type FutureTxList struct {
 	Txs        map[string]types.ITransaction
 	nonceKeMap map[string]string
+	// Number of transactions of each address
+	counts map[string]int
+	bytes  uint64
 }
 
 func NewFutureTxList() *FutureTxList {
 	return &FutureTxList{
 		Txs:        make(map[string]types.ITransaction),
 		nonceKeMap: make(map[string]string),
+		counts:     make(map[string]int),
 	}
 }
 
@@ -24,18 +28,28 @@ func (f *FutureTxList) Put(tx types.ITransaction) error {
 	if oldTxHash := f.GetNonceKeyHash(tx.NonceKey()); oldTxHash != "" {
 		oldTx := f.Txs[oldTxHash]
 		if oldTx.GetFees() > tx.GetFees() {
-			return fmt.Errorf("transation nonce %d exist, the fees must biger than before %d", tx.GetNonce, oldTx.GetFees())
+			return fmt.Errorf("transation nonce %d exist, the fees must biger than before %d", tx.GetNonce(), oldTx.GetFees())
 		}
 		f.Remove(oldTx)
 	}
 	f.Txs[tx.Hash().String()] = tx
 	f.nonceKeMap[tx.NonceKey()] = tx.Hash().String()
+	f.counts[tx.From().String()]++
+	f.bytes += tx.Size()
 	return nil
 }
 
 func (f *FutureTxList) Remove(tx types.ITransaction) {
+	if !f.IsExist(tx.Hash().String()) {
+		return
+	}
 	delete(f.Txs, tx.Hash().String())
 	delete(f.nonceKeMap, tx.NonceKey())
+	from := tx.From().String()
+	if f.counts[from]--; f.counts[from] <= 0 {
+		delete(f.counts, from)
+	}
+	f.bytes -= tx.Size()
 }
 
 func (f *FutureTxList) IsExist(txHash string) bool {
@@ -51,6 +65,16 @@ func (f *FutureTxList) Len() int {
 	return len(f.Txs)
 }
 
+// Number of transactions of the address
+func (f *FutureTxList) Count(from string) int {
+	return f.counts[from]
+}
+
+// Total size of the transactions
+func (f *FutureTxList) Bytes() uint64 {
+	return f.bytes
+}
+
 func (f *FutureTxList) GetAll() types.Transactions {
 	var all types.Transactions
 	for _, tx := range f.Txs {
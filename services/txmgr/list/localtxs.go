@@ -0,0 +1,70 @@
+package list
+
+import (
+	"github.com/uworldao/UWORLD/core/types"
+)
+
+// Maximum time a local transaction stays in the journal
+const LocalTxLifeTime = 60 * 60 * 24
+
+// Journal of transactions submitted through the local rpc. Local
+// transactions are packaged first, are not evicted when the pool is
+// full, and are rebroadcast until they are included in a block.
+type LocalTxs struct {
+	Txs map[string]*LocalTx
+}
+
+type LocalTx struct {
+	Tx types.ITransaction
+	// Time when the transaction was journaled
+	Time uint64
+	// Why the transaction left the pool
+	Reason string
+	// Position of the transaction in the pool
+	Status string
+}
+
+func NewLocalTxs() *LocalTxs {
+	return &LocalTxs{Txs: make(map[string]*LocalTx)}
+}
+
+func (l *LocalTxs) Put(tx types.ITransaction, time uint64) {
+	l.Txs[tx.Hash().String()] = &LocalTx{Tx: tx, Time: time}
+}
+
+func (l *LocalTxs) Get(txHash string) (*LocalTx, bool) {
+	local, ok := l.Txs[txHash]
+	return local, ok
+}
+
+func (l *LocalTxs) IsLocal(tx types.ITransaction) bool {
+	_, ok := l.Txs[tx.Hash().String()]
+	return ok
+}
+
+// Record the reason why a local transaction is removed from the pool
+func (l *LocalTxs) Drop(tx types.ITransaction, reason string) {
+	if local, ok := l.Txs[tx.Hash().String()]; ok {
+		local.Reason = reason
+	}
+}
+
+func (l *LocalTxs) GetAll() []*LocalTx {
+	var all []*LocalTx
+	for _, local := range l.Txs {
+		all = append(all, local)
+	}
+	return all
+}
+
+func (l *LocalTxs) RemoveExpired(timeThreshold uint64) {
+	for hash, local := range l.Txs {
+		if local.Time <= timeThreshold {
+			delete(l.Txs, hash)
+		}
+	}
+}
+
+func (l *LocalTxs) Len() int {
+	return len(l.Txs)
+}
@@ -0,0 +1,55 @@
+package list
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Strategies of evicting the ready transactions when the pool is full,
+// the future transactions are always evicted before them
+const (
+	// Evict the transactions of the address that has waited longest,
+	// the default strategy
+	EvictOldest = "oldest"
+	// Evict the transaction with the lowest fee rate, a new transaction
+	// with a lower fee rate than all of them is rejected
+	EvictByFeeRate = "feerate"
+)
+
+var ErrPoolFull = errors.New("the transaction pool is full")
+
+// Limits of the transaction pool
+type Policy struct {
+	// Maximum number of transactions
+	Capacity int
+	// Maximum total size in bytes of the transactions
+	MaxBytes uint64
+	// Maximum number of transactions of an address
+	AccountTxs int
+	// Seconds a transaction can stay in the pool
+	LifeTime int64
+	Eviction string
+}
+
+func (p *Policy) Verify() error {
+	if p.Capacity <= 0 || p.MaxBytes == 0 || p.AccountTxs <= 0 || p.LifeTime <= 0 {
+		return errors.New("the limits of the transaction pool must be positive")
+	}
+	switch p.Eviction {
+	case EvictByFeeRate, EvictOldest:
+		return nil
+	}
+	return fmt.Errorf("unknown eviction strategy %s", p.Eviction)
+}
+
+// Counters of the transaction pool since the node started
+type metrics struct {
+	added         uint64
+	replaced      uint64
+	expired       uint64
+	evictedFuture uint64
+	evicted       uint64
+	rejectedFull  uint64
+	// Rejected because the address has too many transactions
+	rejectedAccount uint64
+}
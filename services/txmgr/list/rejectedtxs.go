@@ -0,0 +1,43 @@
+package list
+
+import (
+	"github.com/uworldao/UWORLD/core"
+	"github.com/uworldao/UWORLD/core/types"
+)
+
+// Maximum number of rejected transactions kept for inspection
+const maxRejectedTxs = 512
+
+// Ring of the transactions recently rejected or evicted by the pool,
+// the oldest record is overwritten when the ring is full.
+type RejectedTxs struct {
+	txs  []*core.RejectedTx
+	next int
+}
+
+func NewRejectedTxs() *RejectedTxs {
+	return &RejectedTxs{txs: make([]*core.RejectedTx, 0, maxRejectedTxs)}
+}
+
+func (r *RejectedTxs) Put(tx types.ITransaction, reason string, time uint64) {
+	rejected := &core.RejectedTx{Tx: tx, Time: time, Reason: reason}
+	if len(r.txs) < maxRejectedTxs {
+		r.txs = append(r.txs, rejected)
+		return
+	}
+	r.txs[r.next] = rejected
+	r.next = (r.next + 1) % maxRejectedTxs
+}
+
+// Get the rejected transactions, the latest first
+func (r *RejectedTxs) GetAll() []*core.RejectedTx {
+	all := make([]*core.RejectedTx, 0, len(r.txs))
+	for i := len(r.txs) - 1; i >= 0; i-- {
+		all = append(all, r.txs[(r.next+i)%len(r.txs)])
+	}
+	return all
+}
+
+func (r *RejectedTxs) Len() int {
+	return len(r.txs)
+}
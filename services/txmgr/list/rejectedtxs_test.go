@@ -0,0 +1,54 @@
+package list
+
+import (
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/core/types"
+	"github.com/uworldao/UWORLD/param"
+	"strconv"
+	"testing"
+)
+
+func newTestTx(nonce uint64) types.ITransaction {
+	tx := &types.Transaction{
+		TxHead: &types.TransactionHead{
+			TxType: types.NormalTransaction,
+			From:   hasharry.StringToAddress("UWDM1qcsk7UUNANMPKSpALJW7AqpDCy7tdoN"),
+			Nonce:  nonce,
+			Fees:   param.Fees,
+			Time:   nonce,
+		},
+		TxBody: &types.NormalTransactionBody{
+			Contract: param.Token,
+			To:       hasharry.StringToAddress("UWDNQhgkNHCLdVhCFvpo6bGXXdcKtTTfeQZE"),
+			Amount:   1,
+		},
+	}
+	tx.SetHash()
+	return tx
+}
+
+func TestRejectedTxs_Put(t *testing.T) {
+	rejected := NewRejectedTxs()
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		rejected.Put(newTestTx(nonce), strconv.FormatUint(nonce, 10), nonce)
+	}
+	all := rejected.GetAll()
+	if len(all) != 3 || all[0].Tx.GetNonce() != 3 || all[2].Tx.GetNonce() != 1 {
+		t.Fatal("the latest rejected transaction should come first")
+	}
+
+	// The oldest records are overwritten when the ring is full
+	for nonce := uint64(4); nonce <= maxRejectedTxs+10; nonce++ {
+		rejected.Put(newTestTx(nonce), strconv.FormatUint(nonce, 10), nonce)
+	}
+	all = rejected.GetAll()
+	if rejected.Len() != maxRejectedTxs || len(all) != maxRejectedTxs {
+		t.Fatalf("wrong length %d", len(all))
+	}
+	if all[0].Tx.GetNonce() != maxRejectedTxs+10 || all[maxRejectedTxs-1].Tx.GetNonce() != 11 {
+		t.Fatalf("wrong order %d %d", all[0].Tx.GetNonce(), all[maxRejectedTxs-1].Tx.GetNonce())
+	}
+	if all[0].Reason != strconv.FormatUint(maxRejectedTxs+10, 10) {
+		t.Fatalf("wrong reason %s", all[0].Reason)
+	}
+}
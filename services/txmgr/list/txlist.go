@@ -2,15 +2,15 @@ package list
 
 import (
 	"fmt"
+	"github.com/uworldao/UWORLD/common/hasharry"
 	"github.com/uworldao/UWORLD/core"
 	"github.com/uworldao/UWORLD/core/types"
+	"sort"
+	"strconv"
 	"sync"
 	"time"
 )
 
-// Maximum transaction survival time
-const TxLifeTime = 60 * 60 * 3
-
 // List of transactions in the transaction pool
 type TxList struct {
 	// For transactions with a nonce value that is too large,
@@ -21,37 +21,52 @@ type TxList struct {
 
 	// Ready to be packaged as a block transaction list.
 	preparedTxs *TxSortedMap
-	storage     ITxPoolStorage
-	state       core.IAccountState
-	mutex       sync.RWMutex
+
+	// Journal of the transactions submitted by the local node
+	locals *LocalTxs
+	// Transactions recently rejected or evicted
+	rejected *RejectedTxs
+	storage  ITxPoolStorage
+	state    core.IAccountState
+	policy   *Policy
+	metrics  metrics
+	mutex    sync.RWMutex
 }
 
 type ITxPoolStorage interface {
 	Open() error
 	LoadFutureTxs() *FutureTxList
 	LoadPreparesTxs() *TxSortedMap
+	LoadLocalTxs() *LocalTxs
 	SaveFutureTxs(*FutureTxList)
 	SavePreparesTxs(*TxSortedMap)
+	SaveLocalTxs(*LocalTxs)
 	Close() error
 }
 
-func NewTxList(state core.IAccountState, storage ITxPoolStorage) *TxList {
+func NewTxList(state core.IAccountState, storage ITxPoolStorage, policy *Policy) *TxList {
 	return &TxList{
 		preparedTxs: NewTxSortedMap(),
 		futureTxs:   NewFutureTxList(),
+		locals:      NewLocalTxs(),
+		rejected:    NewRejectedTxs(),
 		storage:     storage,
 		state:       state,
+		policy:      policy,
 	}
 }
 
-func (t *TxList) Load() error {
+// Load the transactions saved by the storage, the expired ones are
+// removed against the height of the next block
+func (t *TxList) Load(height uint64) error {
 	if err := t.storage.Open(); err != nil {
 		return err
 	}
 	t.futureTxs = t.storage.LoadFutureTxs()
 	t.preparedTxs = t.storage.LoadPreparesTxs()
-	timeThreshold := uint64(time.Now().Unix() - TxLifeTime)
-	t.RemoveExpiredTx(timeThreshold)
+	t.locals = t.storage.LoadLocalTxs()
+	timeThreshold := uint64(time.Now().Unix() - t.policy.LifeTime)
+	t.RemoveExpiredTx(timeThreshold, height)
 	t.UpdateTxsList()
 	return nil
 }
@@ -59,6 +74,7 @@ func (t *TxList) Load() error {
 func (t *TxList) Close() error {
 	t.storage.SaveFutureTxs(t.futureTxs)
 	t.storage.SavePreparesTxs(t.preparedTxs)
+	t.storage.SaveLocalTxs(t.locals)
 	return t.storage.Close()
 }
 
@@ -73,49 +89,255 @@ func (t *TxList) Len() int {
 // the same nonce value, the transaction fee for the new transaction
 // needs to be greater than the transaction fee for the existing
 // transaction, otherwise add returns an error. If the nonce value
-// of the new transaction is greater than the nonce of the existing
-// transaction, add To the list of future transactions.
+// of the new transaction follows the last ready transaction of the
+// address, add to the ready list, otherwise add to the list of
+// future transactions.
 func (t *TxList) Put(tx types.ITransaction) error {
 	t.mutex.Lock()
 	defer t.mutex.Unlock()
 
+	if err := t.put(tx); err != nil {
+		return err
+	}
+	t.metrics.added++
+	return nil
+}
+
+// Add a transaction submitted by the local node and journal it
+func (t *TxList) PutLocal(tx types.ITransaction) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if err := t.put(tx); err != nil {
+		return err
+	}
+	t.metrics.added++
+	t.locals.Put(tx, uint64(time.Now().Unix()))
+	return nil
+}
+
+func (t *TxList) put(tx types.ITransaction) error {
 	from := tx.From().String()
 	nonce, _ := t.state.GetAccountNonce(tx.From())
-	if nonce == tx.GetNonce()-1 {
-		oldTx := t.preparedTxs.GetByAddress(from)
-		if oldTx != nil {
-			if oldTx.GetNonce() == tx.GetNonce() && oldTx.GetFees() < tx.GetFees() {
-				t.preparedTxs.Remove(oldTx)
-			} else if oldTx.GetNonce() < tx.GetNonce() {
-				t.preparedTxs.Remove(oldTx)
-			} else if oldTx.GetNonce() == tx.GetNonce() {
-				return fmt.Errorf("the same nonce %d transaction already exists, so if you want to replace the nonce transaction, add a fee", tx.GetNonce())
-			} else {
-				return types.ErrTxNonceRepeat
-			}
+	if nonce >= tx.GetNonce() {
+		return types.ErrTxNonceRepeat
+	}
+	if oldTx := t.preparedTxs.GetByNonce(from, tx.GetNonce()); oldTx != nil {
+		if oldTx.GetFees() >= tx.GetFees() {
+			return fmt.Errorf("the same nonce %d transaction already exists, so if you want to replace the nonce transaction, add a fee", tx.GetNonce())
 		}
 		t.preparedTxs.Put(tx)
-	} else if nonce >= tx.GetNonce() {
-		return types.ErrTxNonceRepeat
-	} else {
-		return t.futureTxs.Put(tx)
+		t.drop(oldTx, "replaced by a transaction with higher fees")
+		t.metrics.replaced++
+		return nil
+	}
+
+	nextNonce := nonce + 1
+	if lastNonce, ok := t.preparedTxs.LastNonce(from); ok && lastNonce >= nonce {
+		nextNonce = lastNonce + 1
+	}
+	oldTxHash := t.futureTxs.GetNonceKeyHash(tx.NonceKey())
+	oldTx := t.futureTxs.Txs[oldTxHash]
+	if oldTx == nil && t.preparedTxs.Count(from)+t.futureTxs.Count(from) >= t.policy.AccountTxs {
+		t.metrics.rejectedAccount++
+		return fmt.Errorf("the address has %d transactions in the pool at most", t.policy.AccountTxs)
+	}
+	if tx.GetNonce() != nextNonce {
+		if err := t.futureTxs.Put(tx); err != nil {
+			return err
+		}
+		if oldTx != nil {
+			t.drop(oldTx, "replaced by a transaction with higher fees")
+			t.metrics.replaced++
+		}
+		return nil
+	}
+	if oldTx != nil {
+		t.futureTxs.Remove(oldTx)
+		t.drop(oldTx, "replaced by a transaction with higher fees")
+		t.metrics.replaced++
 	}
+	t.preparedTxs.Put(tx)
+	t.promote(from, tx.GetNonce())
 	return nil
 }
 
-//
-func (t *TxList) RemoveMinFeeTx(newTx types.ITransaction) {
+// Move the future transactions that continue the nonce
+// of the ready transactions to the ready list.
+func (t *TxList) promote(from string, lastNonce uint64) {
+	for {
+		nonceKey := from + "_" + strconv.FormatUint(lastNonce+1, 10)
+		txHash := t.futureTxs.GetNonceKeyHash(nonceKey)
+		if txHash == "" {
+			return
+		}
+		tx := t.futureTxs.Txs[txHash]
+		t.futureTxs.Remove(tx)
+		t.preparedTxs.Put(tx)
+		lastNonce++
+	}
+}
+
+// Transactions that are no longer continuous are moved
+// back to the list of future transactions.
+func (t *TxList) demote(txs types.Transactions) {
+	for _, tx := range txs {
+		t.futureTxs.Put(tx)
+	}
+}
+
+// Make room for a new transaction when the pool is full by the number
+// or the size of the transactions. The whole chains of future transactions
+// are evicted first, the oldest chain first, then the ready transactions
+// by the eviction strategy, always from the end of the chain of an address
+// so that the nonce of the remaining transactions is still continuous.
+// Local transactions are kept, if there is no room left the new
+// transaction is rejected.
+func (t *TxList) MakeRoom(newTx types.ITransaction) error {
 	t.mutex.Lock()
 	defer t.mutex.Unlock()
 
-	t.preparedTxs.PopMin(newTx.GetFees())
+	size := newTx.Size()
+	if !t.isFull(size) {
+		return nil
+	}
+	t.evictFutureChains(size)
+	if !t.isFull(size) {
+		return nil
+	}
+	byFeeRate := t.policy.Eviction == EvictByFeeRate
+	reason := "evicted as the oldest transactions when the pool is full"
+	if byFeeRate {
+		reason = "evicted by a transaction with a higher fee rate"
+	}
+	queue := t.preparedTxs.newEvictionQueue(!byFeeRate, t.locals.IsLocal)
+	for t.isFull(size) {
+		next := queue.Peek()
+		// A new transaction with a lower fee rate than all of them is rejected
+		if next == nil || byFeeRate && lowerFeeRate(newTx, next) {
+			t.metrics.rejectedFull++
+			return ErrPoolFull
+		}
+		t.drop(queue.Evict(), reason)
+		t.metrics.evicted++
+	}
+	return nil
+}
+
+// Whether a new transaction of the size exceeds the limits of the pool
+func (t *TxList) isFull(size uint64) bool {
+	return t.futureTxs.Len()+t.preparedTxs.Len() >= t.policy.Capacity ||
+		t.futureTxs.Bytes()+t.preparedTxs.Bytes()+size > t.policy.MaxBytes
+}
+
+// Evict the future transactions an address at a time, the address
+// whose earliest future transaction is the oldest first, until the new
+// transaction of the size fits. The addresses with local future
+// transactions are skipped.
+func (t *TxList) evictFutureChains(size uint64) {
+	chains := make(map[string]types.Transactions)
+	for _, tx := range t.futureTxs.Txs {
+		from := tx.From().String()
+		chains[from] = append(chains[from], tx)
+	}
+	var froms []string
+	oldest := make(map[string]uint64)
+	for from, chain := range chains {
+		local := false
+		for _, tx := range chain {
+			if t.locals.IsLocal(tx) {
+				local = true
+				break
+			}
+			if first, ok := oldest[from]; !ok || tx.GetTime() < first {
+				oldest[from] = tx.GetTime()
+			}
+		}
+		if !local {
+			froms = append(froms, from)
+		}
+	}
+	sort.Slice(froms, func(i, j int) bool {
+		if oldest[froms[i]] != oldest[froms[j]] {
+			return oldest[froms[i]] < oldest[froms[j]]
+		}
+		return froms[i] < froms[j]
+	})
+	for _, from := range froms {
+		if !t.isFull(size) {
+			return
+		}
+		for _, tx := range chains[from] {
+			t.futureTxs.Remove(tx)
+			t.drop(tx, "evicted with the future transactions of the address when the pool is full")
+			t.metrics.evictedFuture++
+		}
+	}
 }
 
 func (t *TxList) Gets(count int) types.Transactions {
 	t.mutex.RLock()
 	defer t.mutex.RUnlock()
 
-	return t.preparedTxs.Gets(count)
+	return t.preparedTxs.Gets(count, t.locals.IsLocal)
+}
+
+// Take a snapshot of the ready transactions ordered by fee rate
+func (t *TxList) ByFeeRate() *TxsByFeeRate {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.preparedTxs.ByFeeRate(t.locals.IsLocal)
+}
+
+// Get the local transactions that are ready to be packaged
+func (t *TxList) GetLocalPending() types.Transactions {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	var txs types.Transactions
+	for _, local := range t.locals.Txs {
+		if t.preparedTxs.IsExist(local.Tx.From().String(), local.Tx.Hash().String()) {
+			txs = append(txs, local.Tx)
+		}
+	}
+	return txs
+}
+
+// Get the journaled local transactions with their position in the pool
+func (t *TxList) GetLocals() []*LocalTx {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	var locals []*LocalTx
+	for _, local := range t.locals.GetAll() {
+		locals = append(locals, t.localStatus(local))
+	}
+	return locals
+}
+
+func (t *TxList) GetLocal(txHash string) (*LocalTx, bool) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	local, ok := t.locals.Get(txHash)
+	if !ok {
+		return nil, false
+	}
+	return t.localStatus(local), true
+}
+
+func (t *TxList) localStatus(local *LocalTx) *LocalTx {
+	status := *local
+	txHash := local.Tx.Hash().String()
+	if t.preparedTxs.IsExist(local.Tx.From().String(), txHash) {
+		status.Status = core.LocalTxPending
+	} else if t.futureTxs.IsExist(txHash) {
+		status.Status = core.LocalTxFuture
+	} else {
+		status.Status = core.LocalTxDropped
+	}
+	return &status
 }
 
 func (t *TxList) GetAll() (types.Transactions, types.Transactions) {
@@ -138,40 +360,186 @@ func (t *TxList) IsExist(from string, txHash string) bool {
 }
 
 func (t *TxList) UpdateTxsList() {
-	t.mutex.RLock()
-	defer t.mutex.RUnlock()
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
 
-	t.preparedTxs.RemoveExecuted(t.state)
+	// The transactions included in the chain or replaced by another
+	// transaction of the same nonce are not rejected, they are removed
+	// without being recorded
+	for _, tx := range t.preparedTxs.GetAll() {
+		if err := t.state.VerifyState(tx); err != nil {
+			if !t.isSettled(tx) {
+				t.drop(tx, err.Error())
+			}
+			t.demote(t.preparedTxs.Remove(tx))
+		}
+	}
 
 	for _, tx := range t.futureTxs.Txs {
-		nonce, _ := t.state.GetAccountNonce(tx.From())
-		if nonce < tx.GetNonce()-1 {
+		if t.isSettled(tx) {
+			t.futureTxs.Remove(tx)
+		}
+	}
+
+	for _, tx := range t.futureTxs.GetAll() {
+		if !t.futureTxs.IsExist(tx.Hash().String()) {
 			continue
 		}
-		if nonce == tx.GetNonce()-1 {
-			t.preparedTxs.Put(tx)
+		from := tx.From().String()
+		nonce, _ := t.state.GetAccountNonce(tx.From())
+		if lastNonce, ok := t.preparedTxs.LastNonce(from); ok && lastNonce > nonce {
+			nonce = lastNonce
 		}
-		t.futureTxs.Remove(tx)
+		t.promote(from, nonce)
 	}
 }
 
-func (t *TxList) RemoveExpiredTx(timeThreshold uint64) {
+// Remove the transactions that can no longer be included in the block
+// of the height and those older than the time threshold. The journaled
+// local transactions are kept regardless of their time and rebroadcast
+// until they are included, unless they expire by their valid height.
+func (t *TxList) RemoveExpiredTx(timeThreshold, height uint64) {
 	t.mutex.Lock()
 	defer t.mutex.Unlock()
 
-	t.preparedTxs.RemoveExpiredTx(timeThreshold)
+	t.locals.RemoveExpired(uint64(time.Now().Unix() - LocalTxLifeTime))
+
+	for _, tx := range t.preparedTxs.GetAll() {
+		if reason := t.expiredReason(tx, timeThreshold, height); reason != "" {
+			t.drop(tx, reason)
+			t.demote(t.preparedTxs.Remove(tx))
+			t.metrics.expired++
+		}
+	}
 
 	for _, tx := range t.futureTxs.Txs {
-		if tx.GetTime() <= timeThreshold {
+		if reason := t.expiredReason(tx, timeThreshold, height); reason != "" {
+			t.drop(tx, reason)
 			t.futureTxs.Remove(tx)
+			t.metrics.expired++
+		}
+	}
+}
+
+// Why the transaction expires, empty if it does not
+func (t *TxList) expiredReason(tx types.ITransaction, timeThreshold, height uint64) string {
+	if tx.IsExpired(height) {
+		return fmt.Sprintf("expired after height %d", tx.GetTxHead().ValidUntil)
+	}
+	if tx.GetTime() <= timeThreshold && !t.locals.IsLocal(tx) {
+		return "expired"
+	}
+	return ""
+}
+
+// Record a transaction that is not accepted by the pool
+func (t *TxList) Reject(tx types.ITransaction, reason string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.rejected.Put(tx, reason, uint64(time.Now().Unix()))
+}
+
+// Get the transactions recently rejected or evicted, the latest first
+func (t *TxList) GetRejected() []*core.RejectedTx {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.rejected.GetAll()
+}
+
+// Get the transactions of the address, with the nonce values that
+// are missing before its future transactions
+func (t *TxList) GetAddressTxs(address hasharry.Address) *core.AddressTxs {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	from := address.String()
+	nonce, _ := t.state.GetAccountNonce(address)
+	addressTxs := &core.AddressTxs{Address: address, Nonce: nonce}
+	if list, ok := t.preparedTxs.txs[from]; ok {
+		addressTxs.Prepared = list.Txs()
+	}
+	for _, tx := range t.futureTxs.Txs {
+		if tx.From().IsEqual(address) {
+			addressTxs.Future = append(addressTxs.Future, tx)
 		}
 	}
+	sort.Slice(addressTxs.Future, func(i, j int) bool {
+		return addressTxs.Future[i].GetNonce() < addressTxs.Future[j].GetNonce()
+	})
+
+	next := nonce + 1
+	if lastNonce, ok := t.preparedTxs.LastNonce(from); ok && lastNonce >= nonce {
+		next = lastNonce + 1
+	}
+	for _, tx := range addressTxs.Future {
+		if tx.GetNonce() > next {
+			addressTxs.Gaps = append(addressTxs.Gaps, &core.NonceGap{From: next, To: tx.GetNonce() - 1})
+		}
+		if tx.GetNonce() >= next {
+			next = tx.GetNonce() + 1
+		}
+	}
+	return addressTxs
+}
+
+// Get the counts, the total size and the oldest transaction of the pool,
+// with the limits and the counters of the pool
+func (t *TxList) GetStatus() *core.TxPoolStatus {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	status := &core.TxPoolStatus{
+		Prepared: t.preparedTxs.Len(),
+		Future:   t.futureTxs.Len(),
+		Local:    t.locals.Len(),
+		Rejected: t.rejected.Len(),
+		Bytes:    t.preparedTxs.Bytes() + t.futureTxs.Bytes(),
+
+		Capacity:   t.policy.Capacity,
+		MaxBytes:   t.policy.MaxBytes,
+		AccountTxs: t.policy.AccountTxs,
+		LifeTime:   t.policy.LifeTime,
+		Eviction:   t.policy.Eviction,
+
+		Added:           t.metrics.added,
+		Replaced:        t.metrics.replaced,
+		Expired:         t.metrics.expired,
+		EvictedFuture:   t.metrics.evictedFuture,
+		Evicted:         t.metrics.evicted,
+		RejectedFull:    t.metrics.rejectedFull,
+		RejectedAccount: t.metrics.rejectedAccount,
+	}
+	all := append(t.preparedTxs.GetAll(), t.futureTxs.GetAll()...)
+	for _, tx := range all {
+		if status.Oldest == nil || tx.GetTime() < status.Oldest.GetTime() {
+			status.Oldest = tx
+		}
+	}
+	return status
 }
 
-func (t *TxList) Remove(tx types.ITransaction) {
+// Record why a transaction leaves the pool
+// The nonce of the transaction is used by the chain
+func (t *TxList) isSettled(tx types.ITransaction) bool {
+	nonce, _ := t.state.GetAccountNonce(tx.From())
+	return nonce >= tx.GetNonce()
+}
+
+func (t *TxList) drop(tx types.ITransaction, reason string) {
+	t.locals.Drop(tx, reason)
+	t.rejected.Put(tx, reason, uint64(time.Now().Unix()))
+}
+
+func (t *TxList) Remove(tx types.ITransaction, reason string) {
 	t.mutex.Lock()
 	defer t.mutex.Unlock()
 
+	if t.preparedTxs.IsExist(tx.From().String(), tx.Hash().String()) || t.futureTxs.IsExist(tx.Hash().String()) {
+		t.rejected.Put(tx, reason, uint64(time.Now().Unix()))
+	}
+	t.locals.Drop(tx, reason)
 	t.futureTxs.Remove(tx)
-	t.preparedTxs.Remove(tx)
+	t.demote(t.preparedTxs.Remove(tx))
 }
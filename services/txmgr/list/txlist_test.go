@@ -0,0 +1,192 @@
+package list
+
+import (
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/core"
+	"github.com/uworldao/UWORLD/core/types"
+	"github.com/uworldao/UWORLD/param"
+	"testing"
+	"time"
+)
+
+func newAgedTx(from string, nonce, time, fees uint64) types.ITransaction {
+	tx := newFeeTx(from, nonce, fees).(*types.Transaction)
+	tx.TxHead.Time = time
+	tx.SetHash()
+	return tx
+}
+
+func TestTxList_MakeRoom(t *testing.T) {
+	a, b := "UWDM1qcsk7UUNANMPKSpALJW7AqpDCy7tdoN", "UWDNQhgkNHCLdVhCFvpo6bGXXdcKtTTfeQZE"
+	c, d := "UWDCoinEaterAddressDontSend000000000", "UWDH1jpu7SrqYaAxEDbDNM9c6FmTEzWKGgX7"
+	txList := &TxList{
+		preparedTxs: NewTxSortedMap(),
+		futureTxs:   NewFutureTxList(),
+		locals:      NewLocalTxs(),
+		rejected:    NewRejectedTxs(),
+		policy: &Policy{
+			Capacity:   4,
+			MaxBytes:   1024 * 1024,
+			AccountTxs: 10,
+			LifeTime:   60,
+			Eviction:   EvictOldest,
+		},
+	}
+	a1, a2 := newAgedTx(a, 1, 1, param.Fees), newAgedTx(a, 2, 2, param.Fees)
+	b1 := newAgedTx(b, 1, 5, param.Fees)
+	c5 := newAgedTx(c, 5, 10, param.Fees)
+	txList.preparedTxs.Put(a1)
+	txList.preparedTxs.Put(a2)
+	txList.preparedTxs.Put(b1)
+	txList.futureTxs.Put(c5)
+	if bytes := txList.GetStatus().Bytes; bytes != a1.Size()+a2.Size()+b1.Size()+c5.Size() {
+		t.Fatalf("wrong size of the pool %d", bytes)
+	}
+
+	// The future transactions are evicted first
+	newTx := newAgedTx(d, 1, 20, param.Fees)
+	if err := txList.MakeRoom(newTx); err != nil {
+		t.Fatal(err)
+	}
+	if txList.futureTxs.Len() != 0 || txList.preparedTxs.Len() != 3 {
+		t.Fatal("the future transactions should be evicted first")
+	}
+
+	// The local future transactions are kept, the ready transactions of
+	// the oldest address are evicted from the end of the chain
+	d7 := newAgedTx(d, 7, 30, param.Fees)
+	txList.futureTxs.Put(d7)
+	txList.locals.Put(d7, 30)
+	if err := txList.MakeRoom(newTx); err != nil {
+		t.Fatal(err)
+	}
+	if !txList.futureTxs.IsExist(d7.Hash().String()) {
+		t.Fatal("the local transaction should be kept")
+	}
+	if txList.preparedTxs.IsExist(a, a2.Hash().String()) || !txList.preparedTxs.IsExist(a, a1.Hash().String()) {
+		t.Fatal("the last transaction of the oldest address should be evicted")
+	}
+
+	// By fee rate, a new transaction with a lower fee rate is rejected
+	txList.policy.Eviction = EvictByFeeRate
+	txList.preparedTxs.Put(a2)
+	if err := txList.MakeRoom(newAgedTx(d, 1, 20, param.Fees/2)); err != ErrPoolFull {
+		t.Fatalf("expected ErrPoolFull, got %v", err)
+	}
+	if err := txList.MakeRoom(newAgedTx(d, 1, 20, param.Fees*2)); err != nil {
+		t.Fatal(err)
+	}
+
+	status := txList.GetStatus()
+	if status.EvictedFuture != 1 || status.Evicted != 2 || status.RejectedFull != 1 {
+		t.Fatalf("wrong metrics %+v", status)
+	}
+	if status.Bytes != txList.preparedTxs.Bytes()+d7.Size() {
+		t.Fatalf("wrong size of the pool %d", status.Bytes)
+	}
+}
+
+// The nonces of the chain, a transaction fails on the state when its
+// nonce is used or its address is short of balance
+type nonceState struct {
+	core.IAccountState
+	nonces map[string]uint64
+	broke  map[string]bool
+}
+
+func (s *nonceState) GetAccountNonce(address hasharry.Address) (uint64, error) {
+	return s.nonces[address.String()], nil
+}
+
+func (s *nonceState) VerifyState(tx types.ITransaction) error {
+	if s.nonces[tx.From().String()] >= tx.GetNonce() {
+		return types.ErrTxNonceRepeat
+	}
+	if s.broke[tx.From().String()] {
+		return types.ErrNotEnoughBalance
+	}
+	return nil
+}
+
+func TestTxList_UpdateTxsList(t *testing.T) {
+	a, b := "UWDM1qcsk7UUNANMPKSpALJW7AqpDCy7tdoN", "UWDNQhgkNHCLdVhCFvpo6bGXXdcKtTTfeQZE"
+	c := "UWDH1jpu7SrqYaAxEDbDNM9c6FmTEzWKGgX7"
+	state := &nonceState{nonces: map[string]uint64{}, broke: map[string]bool{}}
+	txList := &TxList{
+		preparedTxs: NewTxSortedMap(),
+		futureTxs:   NewFutureTxList(),
+		locals:      NewLocalTxs(),
+		rejected:    NewRejectedTxs(),
+		state:       state,
+	}
+	a1, a2 := newAgedTx(a, 1, 1, param.Fees), newAgedTx(a, 2, 2, param.Fees)
+	b1 := newAgedTx(b, 1, 3, param.Fees)
+	c3 := newAgedTx(c, 3, 4, param.Fees)
+	txList.preparedTxs.Put(a1)
+	txList.preparedTxs.Put(a2)
+	txList.preparedTxs.Put(b1)
+	txList.futureTxs.Put(c3)
+
+	// a1 is included, c3 is replaced by a transaction of the same nonce
+	// and b is short of balance after the block
+	state.nonces[a] = 1
+	state.nonces[c] = 3
+	state.broke[b] = true
+	txList.UpdateTxsList()
+
+	if txList.preparedTxs.IsExist(a, a1.Hash().String()) || !txList.preparedTxs.IsExist(a, a2.Hash().String()) {
+		t.Fatal("the included transaction should be removed")
+	}
+	if txList.preparedTxs.IsExist(b, b1.Hash().String()) || txList.futureTxs.IsExist(c3.Hash().String()) {
+		t.Fatal("the invalid transactions should be removed")
+	}
+	rejected := txList.GetRejected()
+	if len(rejected) != 1 || rejected[0].Tx.Hash() != b1.Hash() {
+		t.Fatalf("only the failed transaction should be recorded, got %d", len(rejected))
+	}
+	if rejected[0].Reason != types.ErrNotEnoughBalance.Error() {
+		t.Fatalf("wrong reason %s", rejected[0].Reason)
+	}
+}
+
+func TestTxList_RemoveExpiredTx(t *testing.T) {
+	a, b := "UWDM1qcsk7UUNANMPKSpALJW7AqpDCy7tdoN", "UWDNQhgkNHCLdVhCFvpo6bGXXdcKtTTfeQZE"
+	c := "UWDH1jpu7SrqYaAxEDbDNM9c6FmTEzWKGgX7"
+	txList := &TxList{
+		preparedTxs: NewTxSortedMap(),
+		futureTxs:   NewFutureTxList(),
+		locals:      NewLocalTxs(),
+		rejected:    NewRejectedTxs(),
+	}
+	now := uint64(time.Now().Unix())
+	peer := newAgedTx(a, 1, 10, param.Fees)
+	local := newAgedTx(b, 1, 10, param.Fees)
+	validUntil := newFeeTx(c, 1, param.Fees).(*types.Transaction)
+	validUntil.TxHead.Time = now
+	validUntil.TxHead.ValidUntil = 5
+	validUntil.SetHash()
+	for _, tx := range []types.ITransaction{peer, local, validUntil} {
+		txList.preparedTxs.Put(tx)
+	}
+	txList.locals.Put(local, now)
+	txList.locals.Put(validUntil, now)
+
+	// The local transaction outlives the lifetime of the pool
+	txList.RemoveExpiredTx(100, 5)
+	if txList.preparedTxs.IsExist(a, peer.Hash().String()) {
+		t.Fatal("the old peer transaction should be removed")
+	}
+	if !txList.preparedTxs.IsExist(b, local.Hash().String()) || !txList.preparedTxs.IsExist(c, validUntil.Hash().String()) {
+		t.Fatal("the local transactions should be kept")
+	}
+
+	// But not its valid height
+	txList.RemoveExpiredTx(100, 6)
+	if txList.preparedTxs.IsExist(c, validUntil.Hash().String()) {
+		t.Fatal("the transaction should expire after its valid height")
+	}
+	status, _ := txList.GetLocal(validUntil.Hash().String())
+	if status.Status != core.LocalTxDropped || status.Reason != "expired after height 5" {
+		t.Fatalf("wrong status %s, reason %s", status.Status, status.Reason)
+	}
+}
@@ -0,0 +1,61 @@
+package list
+
+import (
+	"container/heap"
+	"github.com/uworldao/UWORLD/core/types"
+)
+
+// A snapshot of the ready transactions that yields them in descending
+// order of fee rate, local transactions first. The transactions of the
+// same address are yielded in the order of nonce, the next one becomes
+// available only after the previous one is shifted.
+type TxsByFeeRate struct {
+	txs    map[string]types.Transactions
+	locals map[string]bool
+	heads  *txInfoList
+}
+
+func newTxsByFeeRate(txs map[string]types.Transactions, locals map[string]bool) *TxsByFeeRate {
+	t := &TxsByFeeRate{txs: txs, locals: locals, heads: new(txInfoList)}
+	for addr, list := range txs {
+		if len(list) != 0 {
+			heap.Push(t.heads, newTxInfo(addr, list[0], locals[list[0].Hash().String()]))
+		}
+	}
+	return t
+}
+
+// The transaction with the highest priority, nil if there is none
+func (t *TxsByFeeRate) Peek() types.ITransaction {
+	if t.heads.Len() == 0 {
+		return nil
+	}
+	return t.txs[(*t.heads)[0].address][0]
+}
+
+// Take the transaction with the highest priority, the next transaction
+// of the same address becomes available
+func (t *TxsByFeeRate) Shift() {
+	if t.heads.Len() == 0 {
+		return
+	}
+	address := (*t.heads)[0].address
+	list := t.txs[address][1:]
+	t.txs[address] = list
+	if len(list) == 0 {
+		heap.Pop(t.heads)
+		return
+	}
+	(*t.heads)[0] = newTxInfo(address, list[0], t.locals[list[0].Hash().String()])
+	heap.Fix(t.heads, 0)
+}
+
+// Skip the transaction with the highest priority together with the
+// following transactions of the same address
+func (t *TxsByFeeRate) Pop() {
+	if t.heads.Len() == 0 {
+		return
+	}
+	address := heap.Pop(t.heads).(*txInfo).address
+	delete(t.txs, address)
+}
@@ -0,0 +1,56 @@
+package list
+
+import (
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/core/types"
+	"github.com/uworldao/UWORLD/param"
+	"testing"
+)
+
+func newFeeTx(from string, nonce, fees uint64) types.ITransaction {
+	tx := newTestTx(nonce).(*types.Transaction)
+	tx.TxHead.From = hasharry.StringToAddress(from)
+	tx.TxHead.Fees = fees
+	tx.SetHash()
+	return tx
+}
+
+func TestTxsByFeeRate(t *testing.T) {
+	a, b := "UWDM1qcsk7UUNANMPKSpALJW7AqpDCy7tdoN", "UWDNQhgkNHCLdVhCFvpo6bGXXdcKtTTfeQZE"
+	sorted := NewTxSortedMap()
+	sorted.Put(newFeeTx(a, 1, param.Fees))
+	sorted.Put(newFeeTx(a, 2, param.Fees*3))
+	sorted.Put(newFeeTx(b, 1, param.Fees*2))
+	sorted.Put(newFeeTx(b, 2, param.Fees*2))
+	notLocal := func(types.ITransaction) bool { return false }
+
+	// The transaction with the highest fee waits for the lower nonce
+	expected := []struct {
+		from  string
+		nonce uint64
+	}{{b, 1}, {b, 2}, {a, 1}, {a, 2}}
+	byFeeRate := sorted.ByFeeRate(notLocal)
+	for i, exp := range expected {
+		tx := byFeeRate.Peek()
+		if tx == nil || tx.From().String() != exp.from || tx.GetNonce() != exp.nonce {
+			t.Fatalf("wrong transaction at %d", i)
+		}
+		byFeeRate.Shift()
+	}
+	if byFeeRate.Peek() != nil {
+		t.Fatal("all transactions should be taken")
+	}
+
+	// Popping an address skips its following transactions
+	byFeeRate = sorted.ByFeeRate(notLocal)
+	byFeeRate.Pop()
+	for tx := byFeeRate.Peek(); tx != nil; tx = byFeeRate.Peek() {
+		if tx.From().String() == b {
+			t.Fatal("the transactions of the popped address should be skipped")
+		}
+		byFeeRate.Shift()
+	}
+	if sorted.Len() != 4 {
+		t.Fatal("the snapshot should not change the transactions")
+	}
+}
@@ -2,112 +2,307 @@ package list
 
 import (
 	"container/heap"
-	"github.com/uworldao/UWORLD/core"
 	"github.com/uworldao/UWORLD/core/types"
+	"sort"
 )
 
+// Ready transactions sorted by fee rate. Each address holds a list of
+// transactions with continuous nonce values, which can be packaged
+// into the same block in the order of nonce.
 type TxSortedMap struct {
-	txs   map[string]types.ITransaction
-	cache map[string]types.ITransaction
-	index *txInfoList
+	txs map[string]*nonceTxs
+	// Number and total size of the transactions of all the addresses
+	count int
+	bytes uint64
 }
 
 func NewTxSortedMap() *TxSortedMap {
 	return &TxSortedMap{
-		txs:   make(map[string]types.ITransaction),
-		cache: make(map[string]types.ITransaction),
-		index: new(txInfoList),
+		txs: make(map[string]*nonceTxs),
 	}
 }
 
+// Add a transaction, a transaction with the same
+// nonce value will be replaced.
 func (t *TxSortedMap) Put(tx types.ITransaction) {
-	t.txs[tx.From().String()] = tx
-	t.cache[tx.From().String()] = tx
-	heap.Push(t.index, &txInfo{
-		address: tx.From().String(),
-		txHash:  tx.Hash().String(),
-		fees:    tx.GetFees(),
-		nonce:   tx.GetNonce(),
-		time:    tx.GetTime(),
-	})
+	from := tx.From().String()
+	list, ok := t.txs[from]
+	if !ok {
+		list = newNonceTxs()
+		t.txs[from] = list
+	}
+	t.count -= list.Len()
+	t.bytes -= list.bytes
+	list.Put(tx)
+	t.count += list.Len()
+	t.bytes += list.bytes
 }
 
 func (t *TxSortedMap) GetAll() types.Transactions {
 	var all types.Transactions
-	for _, tx := range t.cache {
-		all = append(all, tx)
+	for _, list := range t.txs {
+		all = append(all, list.Txs()...)
 	}
 	return all
 }
 
-func (t *TxSortedMap) Gets(count int) types.Transactions {
+// Get transactions in descending order of fee rate, local transactions
+// come first. The transactions of the same address are always taken in
+// the order of nonce.
+func (t *TxSortedMap) Gets(count int, isLocal func(types.ITransaction) bool) types.Transactions {
 	var txs types.Transactions
-	rIndex := t.index.CopySelf()
-
-	for rIndex.Len() > 0 && count > 0 {
-		ti := heap.Pop(rIndex).(*txInfo)
-		tx := t.txs[ti.address]
+	byFeeRate := t.ByFeeRate(isLocal)
+	for tx := byFeeRate.Peek(); tx != nil && count > 0; tx = byFeeRate.Peek() {
 		txs = append(txs, tx)
+		byFeeRate.Shift()
 		count--
 	}
 	return txs
 }
 
-func (t *TxSortedMap) GetByAddress(addr string) types.ITransaction {
-	return t.txs[addr]
+// Take a snapshot of the transactions ordered by fee rate
+func (t *TxSortedMap) ByFeeRate(isLocal func(types.ITransaction) bool) *TxsByFeeRate {
+	txs := make(map[string]types.Transactions)
+	locals := make(map[string]bool)
+	for addr, list := range t.txs {
+		txs[addr] = list.Txs()
+		for _, tx := range txs[addr] {
+			if isLocal(tx) {
+				locals[tx.Hash().String()] = true
+			}
+		}
+	}
+	return newTxsByFeeRate(txs, locals)
 }
 
-// If the transaction pool is full, delete the transaction with a small fee
-func (t *TxSortedMap) PopMin(fees uint64) types.ITransaction {
-	if t.Len() > 0 {
-		if (*t.index)[0].fees <= fees {
-			ti := heap.Remove(t.index, 0).(*txInfo)
-			tx := t.txs[ti.address]
-			delete(t.txs, ti.address)
-			delete(t.cache, ti.address)
-			return tx
-		}
+func (t *TxSortedMap) GetByNonce(addr string, nonce uint64) types.ITransaction {
+	list, ok := t.txs[addr]
+	if !ok {
+		return nil
+	}
+	return list.Get(nonce)
+}
+
+// Get the maximum nonce of the address in the ready list
+func (t *TxSortedMap) LastNonce(addr string) (uint64, bool) {
+	list, ok := t.txs[addr]
+	if !ok || list.Len() == 0 {
+		return 0, false
 	}
-	return nil
+	return list.Last().GetNonce(), true
+}
+
+func (t *TxSortedMap) Len() int {
+	return t.count
 }
 
-func (t *TxSortedMap) Len() int { return len(t.txs) }
+// Number of transactions of the address
+func (t *TxSortedMap) Count(from string) int {
+	if list, ok := t.txs[from]; ok {
+		return list.Len()
+	}
+	return 0
+}
+
+// Total size of the transactions
+func (t *TxSortedMap) Bytes() uint64 {
+	return t.bytes
+}
 
 func (t *TxSortedMap) IsExist(from string, txHash string) bool {
-	tx, ok := t.cache[from]
+	list, ok := t.txs[from]
 	if ok {
-		return tx.Hash().String() == txHash
+		return list.IsExist(txHash)
 	}
 	return false
 }
 
-func (t *TxSortedMap) Remove(tx types.ITransaction) {
-	for i, ti := range *(t.index) {
-		if ti.txHash == tx.Hash().String() {
-			heap.Remove(t.index, i)
-			delete(t.txs, tx.From().String())
-			delete(t.cache, tx.From().String())
+// Delete the transaction, transactions with a larger nonce value of
+// the same address are no longer continuous and will be returned.
+func (t *TxSortedMap) Remove(tx types.ITransaction) types.Transactions {
+	from := tx.From().String()
+	list, ok := t.txs[from]
+	if !ok {
+		return nil
+	}
+	old := list.Get(tx.GetNonce())
+	if old == nil || !old.Hash().IsEqual(tx.Hash()) {
+		return nil
+	}
+	t.count -= list.Len()
+	t.bytes -= list.bytes
+	list.Remove(tx.GetNonce())
+	discontinuous := list.RemoveAbove(tx.GetNonce())
+	t.count += list.Len()
+	t.bytes += list.bytes
+	if list.Len() == 0 {
+		delete(t.txs, from)
+	}
+	return discontinuous
+}
+
+// The addresses whose last transaction can be evicted when the pool is
+// full, ordered by the eviction strategy. Only the last transaction of
+// an address is evicted, so that the nonce of the remaining transactions
+// is still continuous, and the addresses whose last transaction is local
+// are skipped. The queue is built once for the evictions that make room
+// for a new transaction and kept in order as the transactions are
+// evicted, instead of scanning all the addresses for each of them.
+type evictionQueue struct {
+	sortedMap *TxSortedMap
+	lists     []*nonceTxs
+	less      func(a, b *nonceTxs) bool
+	isLocal   func(types.ITransaction) bool
+}
+
+// Order the addresses by the time of their first transaction, the oldest
+// first, or by the fee rate of their last transaction, the lowest first
+func (t *TxSortedMap) newEvictionQueue(byOldest bool, isLocal func(types.ITransaction) bool) *evictionQueue {
+	q := &evictionQueue{sortedMap: t, isLocal: isLocal}
+	if byOldest {
+		q.less = func(a, b *nonceTxs) bool { return a.First().GetTime() < b.First().GetTime() }
+	} else {
+		q.less = func(a, b *nonceTxs) bool { return lowerFeeRate(a.Last(), b.Last()) }
+	}
+	for _, list := range t.txs {
+		if last := list.Last(); last != nil && !isLocal(last) {
+			q.lists = append(q.lists, list)
+		}
+	}
+	heap.Init(q)
+	return q
+}
+
+// The transaction to be evicted next, nil if there is none
+func (q *evictionQueue) Peek() types.ITransaction {
+	if len(q.lists) == 0 {
+		return nil
+	}
+	return q.lists[0].Last()
+}
+
+// Delete the transaction to be evicted next from the map
+func (q *evictionQueue) Evict() types.ITransaction {
+	last := q.Peek()
+	if last == nil {
+		return nil
+	}
+	q.sortedMap.Remove(last)
+	if next := q.lists[0].Last(); next == nil || q.isLocal(next) {
+		heap.Pop(q)
+	} else {
+		heap.Fix(q, 0)
+	}
+	return last
+}
+
+func (q *evictionQueue) Len() int           { return len(q.lists) }
+func (q *evictionQueue) Less(i, j int) bool { return q.less(q.lists[i], q.lists[j]) }
+func (q *evictionQueue) Swap(i, j int)      { q.lists[i], q.lists[j] = q.lists[j], q.lists[i] }
+
+func (q *evictionQueue) Push(x interface{}) {
+	q.lists = append(q.lists, x.(*nonceTxs))
+}
+
+func (q *evictionQueue) Pop() interface{} {
+	old := q.lists
+	n := len(old)
+	x := old[n-1]
+	q.lists = old[0 : n-1]
+	return x
+}
+
+// Transactions of one address indexed by nonce
+type nonceTxs struct {
+	txs    map[uint64]types.ITransaction
+	nonces []uint64
+	bytes  uint64
+}
+
+func newNonceTxs() *nonceTxs {
+	return &nonceTxs{txs: make(map[uint64]types.ITransaction)}
+}
+
+func (n *nonceTxs) Put(tx types.ITransaction) {
+	nonce := tx.GetNonce()
+	if old, ok := n.txs[nonce]; !ok {
+		n.nonces = append(n.nonces, nonce)
+		sort.Slice(n.nonces, func(i, j int) bool { return n.nonces[i] < n.nonces[j] })
+	} else {
+		n.bytes -= old.Size()
+	}
+	n.txs[nonce] = tx
+	n.bytes += tx.Size()
+}
+
+func (n *nonceTxs) Get(nonce uint64) types.ITransaction {
+	return n.txs[nonce]
+}
+
+func (n *nonceTxs) First() types.ITransaction {
+	if len(n.nonces) == 0 {
+		return nil
+	}
+	return n.txs[n.nonces[0]]
+}
+
+func (n *nonceTxs) Last() types.ITransaction {
+	if len(n.nonces) == 0 {
+		return nil
+	}
+	return n.txs[n.nonces[len(n.nonces)-1]]
+}
+
+func (n *nonceTxs) Remove(nonce uint64) {
+	for i, v := range n.nonces {
+		if v == nonce {
+			n.nonces = append(n.nonces[0:i], n.nonces[i+1:]...)
+			n.bytes -= n.txs[nonce].Size()
+			delete(n.txs, nonce)
 			return
 		}
 	}
 }
 
-// Delete already packed transactions
-func (t *TxSortedMap) RemoveExecuted(state core.IAccountState) {
-	for _, tx := range t.cache {
-		if err := state.VerifyState(tx); err != nil {
-			t.Remove(tx)
+// Delete all transactions with nonce greater than the given value
+func (n *nonceTxs) RemoveAbove(nonce uint64) types.Transactions {
+	var removed types.Transactions
+	for i, v := range n.nonces {
+		if v > nonce {
+			for _, above := range n.nonces[i:] {
+				removed = append(removed, n.txs[above])
+				n.bytes -= n.txs[above].Size()
+				delete(n.txs, above)
+			}
+			n.nonces = n.nonces[0:i]
+			break
 		}
 	}
+	return removed
 }
 
-// Delete expired transactions
-func (t *TxSortedMap) RemoveExpiredTx(timeThreshold uint64) {
-	for _, tx := range t.cache {
-		if tx.GetTime() <= timeThreshold {
-			t.Remove(tx)
+func (n *nonceTxs) IsExist(txHash string) bool {
+	for _, tx := range n.txs {
+		if tx.Hash().String() == txHash {
+			return true
 		}
 	}
+	return false
+}
+
+func (n *nonceTxs) Txs() types.Transactions {
+	var txs types.Transactions
+	for _, nonce := range n.nonces {
+		txs = append(txs, n.txs[nonce])
+	}
+	return txs
+}
+
+func (n *nonceTxs) Len() int { return len(n.nonces) }
+
+// Whether the fee per byte of transaction a is lower than that of b
+func lowerFeeRate(a, b types.ITransaction) bool {
+	return a.GetFees()*b.Size() < b.GetFees()*a.Size()
 }
 
 type txInfoList []*txInfo
@@ -116,13 +311,40 @@ type txInfo struct {
 	address string
 	txHash  string
 	fees    uint64
+	size    uint64
 	nonce   uint64
 	time    uint64
+	local   bool
+}
+
+func newTxInfo(address string, tx types.ITransaction, local bool) *txInfo {
+	return &txInfo{
+		address: address,
+		txHash:  tx.Hash().String(),
+		fees:    tx.GetFees(),
+		size:    tx.Size(),
+		nonce:   tx.GetNonce(),
+		time:    tx.GetTime(),
+		local:   local,
+	}
+}
+
+func (t txInfoList) Len() int { return len(t) }
+
+// Local transaction first, then higher fee per byte first, the
+// earlier transaction first if the same
+func (t txInfoList) Less(i, j int) bool {
+	if t[i].local != t[j].local {
+		return t[i].local
+	}
+	left, right := t[i].fees*t[j].size, t[j].fees*t[i].size
+	if left == right {
+		return t[i].time < t[j].time
+	}
+	return left > right
 }
 
-func (t txInfoList) Len() int           { return len(t) }
-func (t txInfoList) Less(i, j int) bool { return t[i].fees > t[j].fees }
-func (t txInfoList) Swap(i, j int)      { t[i], t[j] = t[j], t[i] }
+func (t txInfoList) Swap(i, j int) { t[i], t[j] = t[j], t[i] }
 
 func (t *txInfoList) Push(x interface{}) {
 	*t = append(*t, x.(*txInfo))
@@ -135,20 +357,3 @@ func (t *txInfoList) Pop() interface{} {
 	*t = old[0 : n-1]
 	return x
 }
-
-func (t *txInfoList) CopySelf() *txInfoList {
-	reReelList := new(txInfoList)
-	for _, nonce := range *t {
-		*reReelList = append(*reReelList, nonce)
-	}
-	return reReelList
-}
-
-func (t *txInfoList) FindIndex(addr string, nonce uint64) int {
-	for index, ti := range *t {
-		if ti.address == addr && ti.nonce <= nonce {
-			return index
-		}
-	}
-	return -1
-}
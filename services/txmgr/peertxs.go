@@ -0,0 +1,180 @@
+package txmgr
+
+import (
+	"sync"
+	"time"
+)
+
+// Maximum number of transaction hashes remembered for each peer
+const maxKnownTxs = 4096
+
+// Penalty points after which a peer is banned
+const maxPeerPenalty = 100
+
+// Penalty points for sending an invalid transaction
+const invalidTxPenalty = 10
+
+// Ban time of a peer that keeps sending invalid transactions
+const peerBanTime = 60 * 30
+
+// Hashes of the transactions known by a peer, the oldest hash is
+// forgotten when the set is full
+type knownTxs struct {
+	hashes map[string]struct{}
+	queue  []string
+}
+
+func newKnownTxs() *knownTxs {
+	return &knownTxs{hashes: make(map[string]struct{})}
+}
+
+func (k *knownTxs) Add(txHash string) {
+	if _, ok := k.hashes[txHash]; ok {
+		return
+	}
+	if len(k.queue) >= maxKnownTxs {
+		delete(k.hashes, k.queue[0])
+		k.queue = k.queue[1:]
+	}
+	k.hashes[txHash] = struct{}{}
+	k.queue = append(k.queue, txHash)
+}
+
+func (k *knownTxs) Has(txHash string) bool {
+	_, ok := k.hashes[txHash]
+	return ok
+}
+
+// Relay state of the peers. Transactions are not sent to the peers
+// that already know them, and peers that keep sending invalid
+// transactions are banned for a while.
+type peerTxs struct {
+	known     map[string]*knownTxs
+	penalties map[string]int
+	banned    map[string]int64
+	synced    map[string]bool
+	mutex     sync.Mutex
+}
+
+func newPeerTxs() *peerTxs {
+	return &peerTxs{
+		known:     make(map[string]*knownTxs),
+		penalties: make(map[string]int),
+		banned:    make(map[string]int64),
+		synced:    make(map[string]bool),
+	}
+}
+
+// Record that the peer knows the transaction
+func (p *peerTxs) MarkKnown(peerId string, txHash string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	known, ok := p.known[peerId]
+	if !ok {
+		known = newKnownTxs()
+		p.known[peerId] = known
+	}
+	known.Add(txHash)
+}
+
+// Record that the transaction is sent to the peer, false if the
+// peer already knows the transaction
+func (p *peerTxs) MarkSent(peerId string, txHash string) bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	known, ok := p.known[peerId]
+	if !ok {
+		known = newKnownTxs()
+		p.known[peerId] = known
+	}
+	if known.Has(txHash) {
+		return false
+	}
+	known.Add(txHash)
+	return true
+}
+
+// Record that the pool is synchronized with the peer, false if it
+// already is
+func (p *peerTxs) MarkSynced(peerId string) bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.synced[peerId] {
+		return false
+	}
+	p.synced[peerId] = true
+	return true
+}
+
+// Add penalty points to the peer, true if the peer is banned
+func (p *peerTxs) Penalize(peerId string, points int) bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.penalties[peerId] += points
+	if p.penalties[peerId] < maxPeerPenalty {
+		return false
+	}
+	delete(p.penalties, peerId)
+	delete(p.known, peerId)
+	p.banned[peerId] = time.Now().Unix() + peerBanTime
+	return true
+}
+
+func (p *peerTxs) IsBanned(peerId string) bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	until, ok := p.banned[peerId]
+	if !ok {
+		return false
+	}
+	if time.Now().Unix() >= until {
+		delete(p.banned, peerId)
+		return false
+	}
+	return true
+}
+
+// Forget the peers that are no longer connected and the expired
+// bans, the penalty points of the other peers decay by one
+func (p *peerTxs) Clean(connected map[string]bool) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	for peerId := range p.known {
+		if !connected[peerId] {
+			delete(p.known, peerId)
+		}
+	}
+	for peerId := range p.synced {
+		if !connected[peerId] {
+			delete(p.synced, peerId)
+		}
+	}
+	for peerId, points := range p.penalties {
+		if !connected[peerId] || points <= 1 {
+			delete(p.penalties, peerId)
+		} else {
+			p.penalties[peerId] = points - 1
+		}
+	}
+	now := time.Now().Unix()
+	for peerId, until := range p.banned {
+		if now >= until {
+			delete(p.banned, peerId)
+		}
+	}
+}
+
+// Error of a transaction that is invalid regardless of the state
+type invalidTxError struct {
+	err error
+}
+
+func (e *invalidTxError) Error() string {
+	return e.err.Error()
+}
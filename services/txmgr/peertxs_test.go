@@ -0,0 +1,73 @@
+package txmgr
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestPeerTxs_MarkSent(t *testing.T) {
+	p := newPeerTxs()
+	p.MarkKnown("sender", "0x01")
+	if p.MarkSent("sender", "0x01") {
+		t.Fatal("the transaction should not be sent back to the sender")
+	}
+	if !p.MarkSent("other", "0x01") {
+		t.Fatal("the transaction should be sent to another peer")
+	}
+	if p.MarkSent("other", "0x01") {
+		t.Fatal("the transaction should be sent only once")
+	}
+
+	// The oldest hashes are forgotten when the set is full
+	for i := 0; i < maxKnownTxs; i++ {
+		p.MarkKnown("sender", strconv.Itoa(i))
+	}
+	if !p.MarkSent("sender", "0x01") {
+		t.Fatal("the oldest hash should be forgotten")
+	}
+
+	p.Clean(map[string]bool{"other": true})
+	if !p.MarkSent("sender", "0x02") {
+		t.Fatal("the known set of a disconnected peer should be released")
+	}
+}
+
+func TestPeerTxs_Penalize(t *testing.T) {
+	p := newPeerTxs()
+	for i := 0; i < maxPeerPenalty/invalidTxPenalty-1; i++ {
+		if p.Penalize("peer", invalidTxPenalty) {
+			t.Fatalf("banned after %d invalid transactions", i+1)
+		}
+	}
+	if p.IsBanned("peer") {
+		t.Fatal("the peer should not be banned yet")
+	}
+
+	// Penalty points decay over time
+	p.Clean(map[string]bool{"peer": true})
+	if p.Penalize("peer", 1) {
+		t.Fatal("the penalty should have decayed")
+	}
+	if !p.Penalize("peer", invalidTxPenalty) {
+		t.Fatal("the peer should be banned")
+	}
+	if !p.IsBanned("peer") {
+		t.Fatal("the peer should be banned")
+	}
+}
+
+func TestPeerTxs_MarkSynced(t *testing.T) {
+	p := newPeerTxs()
+	if !p.MarkSynced("peer") {
+		t.Fatal("the pool is not synchronized with the peer yet")
+	}
+	if p.MarkSynced("peer") {
+		t.Fatal("the pool is already synchronized with the peer")
+	}
+
+	// A peer that reconnects is synchronized again
+	p.Clean(map[string]bool{})
+	if !p.MarkSynced("peer") {
+		t.Fatal("the disconnected peer should be forgotten")
+	}
+}
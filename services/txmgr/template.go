@@ -0,0 +1,172 @@
+package txmgr
+
+import (
+	"fmt"
+	"github.com/uworldao/UWORLD/common/encode/rlp"
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/core"
+	"github.com/uworldao/UWORLD/core/types"
+	"time"
+)
+
+// Build the transactions of the next block. The ready transactions are
+// taken in descending order of fee rate, the transactions of the same
+// address in the order of nonce, until the count or the size limit is
+// reached. Each transaction is applied to a scratch copy of the state
+// in the same way as the block applies it, a transaction that fails is
+// rolled back and the following transactions of its address are
+// skipped. Transactions whose fees are lower than the current minimum,
+// or whose proof of work is below the target of the minimum, stay in
+// the pool until the minimum falls. The pool is not changed, the miner
+// removes the invalid transactions of the template.
+func (tp *TxPool) GetBlockTemplate(maxCount int, maxSize uint64) *core.BlockTemplate {
+	height := tp.blockChain.GetLastHeight() + 1
+	minFees := tp.blockChain.GetMinFees()
+	template := &core.BlockTemplate{Height: height, Txs: types.Transactions{}, Failed: []*core.RejectedTx{}}
+	state := newScratchState(tp.accountState.GetAccountState)
+	// An HTLC can only be claimed or refunded once in a block
+	settled := make(map[hasharry.Hash]bool)
+	// The coins issued by a contract in a block must not exceed the total limit
+	issued := make(map[string]uint64)
+	// The new issuer of a contract can use the rights from the next block
+	transferred := make(map[string]bool)
+	// A name can only be changed once in a block
+	named := make(map[string]bool)
+	// The later transactions of a rotated address must be signed by the new key
+	rotations := make(types.Rotations)
+
+	byFeeRate := tp.txs.ByFeeRate()
+	for tx := byFeeRate.Peek(); tx != nil && len(template.Txs) < maxCount; tx = byFeeRate.Peek() {
+		if tx.VerifyMinFees(minFees) != nil {
+			byFeeRate.Pop()
+			continue
+		}
+		// A smaller transaction of another address may still fit
+		size := tx.Size()
+		if template.Size+size > maxSize {
+			byFeeRate.Pop()
+			continue
+		}
+		if err := tp.verifyTx(tx); err != nil {
+			rejected := &core.RejectedTx{Tx: tx, Time: uint64(time.Now().Unix()), Reason: err.Error()}
+			template.Failed = append(template.Failed, rejected)
+			template.Invalid = append(template.Invalid, rejected)
+			byFeeRate.Pop()
+			continue
+		}
+
+		id, isSettle := types.HTLCId(tx)
+		name, isName := types.TxName(tx)
+		contractAddr := tx.GetTxBody().GetContract().String()
+		issuedAmount, isIssued := issued[contractAddr]
+		var err error
+		if isSettle && settled[id] {
+			err = fmt.Errorf("htlc %s is settled in the block", id.String())
+		} else if isName && named[name] {
+			err = fmt.Errorf("name %s is changed in the block", name)
+		} else if types.IsIssuerTransaction(tx) && transferred[contractAddr] {
+			err = fmt.Errorf("the issuer of contract %s is changed in the block", contractAddr)
+		} else if signerErr := rotations.VerifySigners(tx); signerErr != nil {
+			err = fmt.Errorf("the key of %s is rotated in the block: %s", tx.From().String(), signerErr.Error())
+		} else if err = tp.verifyIssue(tx, issued); err == nil {
+			if err = tp.applyTx(tx, height, state.GetAccount); err != nil {
+				state.Revert()
+			}
+		}
+		if err != nil {
+			// The issuance recorded by verifyIssue is not applied
+			if isIssued {
+				issued[contractAddr] = issuedAmount
+			} else {
+				delete(issued, contractAddr)
+			}
+			template.Failed = append(template.Failed, &core.RejectedTx{Tx: tx, Time: uint64(time.Now().Unix()), Reason: err.Error()})
+			byFeeRate.Pop()
+			continue
+		}
+		state.Commit()
+
+		if isSettle {
+			settled[id] = true
+		}
+		if isName {
+			named[name] = true
+		}
+		if tx.GetTxType() == types.ContractIssuerTransaction {
+			transferred[contractAddr] = true
+		}
+		rotations.Add(tx)
+		template.Txs = append(template.Txs, tx)
+		template.Size += size
+		byFeeRate.Shift()
+	}
+	template.Fees = template.Txs.SumFees()
+	template.Consumption = template.Txs.SumConsumption()
+	return template
+}
+
+// Copies of the accounts changed by the transactions of a block
+// template. The changes of the current transaction can be reverted.
+type scratchState struct {
+	accounts map[string]types.IAccount
+	// Accounts before the current transaction, nil if the account
+	// was not loaded
+	journal  map[string]types.IAccount
+	getState func(hasharry.Address) types.IAccount
+}
+
+func newScratchState(getState func(hasharry.Address) types.IAccount) *scratchState {
+	return &scratchState{
+		accounts: make(map[string]types.IAccount),
+		journal:  make(map[string]types.IAccount),
+		getState: getState,
+	}
+}
+
+func (s *scratchState) GetAccount(address hasharry.Address) types.IAccount {
+	key := address.String()
+	account, ok := s.accounts[key]
+	if _, journaled := s.journal[key]; !journaled {
+		if ok {
+			s.journal[key] = copyAccount(account)
+		} else {
+			s.journal[key] = nil
+		}
+	}
+	if !ok {
+		account = s.getState(address)
+		s.accounts[key] = account
+	}
+	return account
+}
+
+// Keep the changes of the current transaction
+func (s *scratchState) Commit() {
+	s.journal = make(map[string]types.IAccount)
+}
+
+// Discard the changes of the current transaction
+func (s *scratchState) Revert() {
+	for key, account := range s.journal {
+		if account == nil {
+			delete(s.accounts, key)
+		} else {
+			s.accounts[key] = account
+		}
+	}
+	s.journal = make(map[string]types.IAccount)
+}
+
+// Copy the account through its encoding, which is how the state
+// stores it
+func copyAccount(account types.IAccount) types.IAccount {
+	bytes, err := rlp.EncodeToBytes(account.(*types.Account))
+	if err != nil {
+		return account
+	}
+	copied := types.NewAccount()
+	if err := rlp.DecodeBytes(bytes, &copied); err != nil {
+		return account
+	}
+	return copied
+}
@@ -2,7 +2,9 @@ package txmgr
 
 import (
 	"errors"
+	"fmt"
 	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/uworldao/UWORLD/common/hasharry"
 	"github.com/uworldao/UWORLD/config"
 	"github.com/uworldao/UWORLD/consensus"
 	"github.com/uworldao/UWORLD/core"
@@ -10,49 +12,92 @@ import (
 	"github.com/uworldao/UWORLD/database/pooldb"
 	log "github.com/uworldao/UWORLD/log/log15"
 	"github.com/uworldao/UWORLD/p2p"
+	"github.com/uworldao/UWORLD/param"
 	"github.com/uworldao/UWORLD/services/blkmgr"
 	"github.com/uworldao/UWORLD/services/txmgr/list"
+	"sync"
 	"time"
 )
 
-// Clear the expired transaction interval
-const monitorTxInterval = 20
+// Rebroadcast the local transactions interval
+const rebroadcastInterval = 60
 
 const txChanLength = 50
 
-// Maximum number of transactions in the transaction pool
-const maxPoolTx = 50000
+// The new transactions are relayed to the peers in batches, a batch is
+// sent when it is full or at the interval in milliseconds
+const relayInterval = 500
+
+const maxRelayTxs = 100
+
+// Look for the newly connected peers to synchronize the pool with
+// at the interval
+const poolSyncInterval = 10
+
+// Maximum number of peers the pool is synchronized with at a time
+const maxPoolSyncs = 3
+
+// Transactions fetched from a peer in one request
+const poolSyncBatch = 100
+
+// Defaults of the transaction pool settings that are not configured
+const (
+	defaultPoolCapacity = 50000
+	defaultPoolMaxBytes = 32 * 1024 * 1024
+	defaultPoolLifeTime = 60 * 60 * 3
+	defaultPoolEviction = list.EvictOldest
+
+	// Clear the expired transaction interval
+	defaultClearInterval = 20
+)
 
 const txPoolStorage = "txpool"
 
 // Manage transactions not packaged into blocks
 type TxPool struct {
+	blockChain    core.IBlockChain
 	accountState  core.IAccountState
 	contractState core.IContractState
+	htlcState     core.IHTLCState
+	nameState     core.INameState
 	consensus     consensus.IConsensus
 	txs           *list.TxList
+	policy        *list.Policy
+	clearInterval int64
 	peerManager   p2p.IPeerManager
 	network       blkmgr.Network
 	newStream     blkmgr.ICreateStream
 	txChan        chan types.ITransaction
-	recTx         chan types.ITransaction
+	recTx         chan *core.PeerTx
+	peerTxs       *peerTxs
 	removeTxsCh   chan types.Transactions
 	stateUpdateCh chan struct{}
 	stop          chan bool
 }
 
-func NewTxPool(config *config.Config, accountState core.IAccountState, contractState core.IContractState, consensus consensus.IConsensus, peerManager p2p.IPeerManager, network blkmgr.Network,
-	recTx chan types.ITransaction, stateUpdateCh chan struct{}, removeTxsCh chan types.Transactions,
+func NewTxPool(config *config.Config, blockChain core.IBlockChain, accountState core.IAccountState, contractState core.IContractState, htlcState core.IHTLCState, nameState core.INameState, consensus consensus.IConsensus, peerManager p2p.IPeerManager, network blkmgr.Network,
+	recTx chan *core.PeerTx, stateUpdateCh chan struct{}, removeTxsCh chan types.Transactions,
 	newStream blkmgr.ICreateStream) *TxPool {
 
+	policy := newPolicy(config)
+	clearInterval := config.TxPoolMonitor
+	if clearInterval == 0 {
+		clearInterval = defaultClearInterval
+	}
 	return &TxPool{
+		blockChain:    blockChain,
 		accountState:  accountState,
 		contractState: contractState,
+		htlcState:     htlcState,
+		nameState:     nameState,
 		consensus:     consensus,
-		txs:           list.NewTxList(accountState, pooldb.NewTxPoolStorage(config.DataDir+"/"+txPoolStorage)),
+		txs:           list.NewTxList(accountState, pooldb.NewTxPoolStorage(config.DataDir+"/"+txPoolStorage), policy),
+		policy:        policy,
+		clearInterval: clearInterval,
 		peerManager:   peerManager,
 		network:       network,
 		recTx:         recTx,
+		peerTxs:       newPeerTxs(),
 		removeTxsCh:   removeTxsCh,
 		stateUpdateCh: stateUpdateCh,
 		newStream:     newStream,
@@ -61,61 +106,238 @@ func NewTxPool(config *config.Config, accountState core.IAccountState, contractS
 	}
 }
 
+// The policy of the pool configured by the node, the settings left 0
+// or empty are the defaults
+func newPolicy(config *config.Config) *list.Policy {
+	policy := &list.Policy{
+		Capacity:   config.TxPoolCapacity,
+		MaxBytes:   config.TxPoolMaxBytes,
+		AccountTxs: config.TxPoolAccountTxs,
+		LifeTime:   config.TxPoolLifeTime,
+		Eviction:   config.TxPoolEviction,
+	}
+	if policy.Capacity == 0 {
+		policy.Capacity = defaultPoolCapacity
+	}
+	if policy.MaxBytes == 0 {
+		policy.MaxBytes = defaultPoolMaxBytes
+	}
+	if policy.AccountTxs == 0 {
+		policy.AccountTxs = param.MaxAddressTxs
+	}
+	if policy.LifeTime == 0 {
+		policy.LifeTime = defaultPoolLifeTime
+	}
+	if policy.Eviction == "" {
+		policy.Eviction = defaultPoolEviction
+	}
+	return policy
+}
+
 // Start transaction pool
 func (tp *TxPool) Start() error {
-	if err := tp.txs.Load(); err != nil {
+	if err := tp.policy.Verify(); err != nil {
+		return err
+	}
+	if tp.clearInterval <= 0 {
+		return errors.New("the interval of clearing the expired transactions must be positive")
+	}
+	if err := tp.txs.Load(tp.blockChain.GetLastHeight() + 1); err != nil {
 		return err
 	}
 
 	go tp.monitorTxTime()
+	go tp.rebroadcastLocalTxs()
+	go tp.syncPeers()
 	go tp.dealTx()
 
 	log.Info("Transaction pool startup successful")
 	return nil
 }
 
+// Stop the transaction pool, closing the stop channel ends all the
+// goroutines of the pool
 func (tp *TxPool) Stop() error {
-	tp.stop <- true
+	close(tp.stop)
 	log.Info("Stop transaction pool")
 	return tp.txs.Close()
 }
 
 // Monitor transaction time
 func (tp *TxPool) monitorTxTime() {
-	t := time.NewTicker(time.Second * monitorTxInterval)
+	t := time.NewTicker(time.Second * time.Duration(tp.clearInterval))
 	defer t.Stop()
 
-	for range t.C {
-		tp.clearExpiredTx()
+	for {
+		select {
+		case <-tp.stop:
+			return
+		case <-t.C:
+			tp.clearExpiredTx()
+			tp.cleanPeerTxs()
+		}
+	}
+}
+
+// Periodically rebroadcast the local transactions that have not been
+// packaged, in case peers dropped them or were not connected yet.
+func (tp *TxPool) rebroadcastLocalTxs() {
+	t := time.NewTicker(time.Second * rebroadcastInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-tp.stop:
+			return
+		case <-t.C:
+			tp.broadcastTxs(tp.txs.GetLocalPending(), false)
+		}
+	}
+}
+
+// Synchronize the pool with the newly connected peers, so that a
+// restarted node learns about the pending transactions without waiting
+// for them to be rebroadcast.
+func (tp *TxPool) syncPeers() {
+	t := time.NewTicker(time.Second * poolSyncInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-tp.stop:
+			return
+		case <-t.C:
+			tp.syncNewPeers()
+		}
+	}
+}
+
+// Synchronize the pool with at most maxPoolSyncs peers that are not
+// synchronized yet, and wait for them to finish
+func (tp *TxPool) syncNewPeers() {
+	var wg sync.WaitGroup
+	syncs := 0
+	for id := range tp.peerManager.Peers() {
+		if syncs >= maxPoolSyncs {
+			break
+		}
+		if id == tp.peerManager.LocalPeerInfo().AddrInfo.ID.String() || tp.peerTxs.IsBanned(id) {
+			continue
+		}
+		if !tp.peerTxs.MarkSynced(id) {
+			continue
+		}
+		syncs++
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			if err := tp.syncPeer(id); err != nil {
+				log.Warn("Failed to synchronize the pool with peer", "peer", id, "error", err)
+			}
+		}(id)
 	}
+	wg.Wait()
+}
+
+// Exchange the pending transaction hashes with the peer and fetch the
+// transactions that are missing from the pool
+func (tp *TxPool) syncPeer(id string) error {
+	peerId := new(peer.ID)
+	if err := peerId.UnmarshalText([]byte(id)); err != nil {
+		return err
+	}
+	streamCreator := &p2p.StreamCreator{PeerId: *peerId, NewStreamFunc: tp.newStream.CreateStream}
+	hashes, err := tp.network.GetPoolTxHashes(streamCreator)
+	if err != nil {
+		return err
+	}
+
+	exist := make(map[hasharry.Hash]bool)
+	preparedTxs, futureTxs := tp.txs.GetAll()
+	for _, tx := range append(preparedTxs, futureTxs...) {
+		exist[tx.Hash()] = true
+	}
+	var missing []hasharry.Hash
+	for _, hash := range hashes {
+		tp.peerTxs.MarkKnown(id, hash.String())
+		if !exist[hash] {
+			missing = append(missing, hash)
+		}
+	}
+
+	for start := 0; start < len(missing); start += poolSyncBatch {
+		end := start + poolSyncBatch
+		if end > len(missing) {
+			end = len(missing)
+		}
+		txs, err := tp.network.GetPoolTxs(streamCreator, missing[start:end])
+		if err != nil {
+			return err
+		}
+		for _, tx := range txs {
+			tp.addPeerTx(&core.PeerTx{Tx: tx, PeerId: id})
+		}
+		if tp.peerTxs.IsBanned(id) {
+			return errors.New("peer is banned")
+		}
+	}
+	log.Info("Synchronized the pool with peer", "peer", id, "hashes", len(hashes), "fetched", len(missing))
+	return nil
 }
 
 func (tp *TxPool) dealTx() {
+	t := time.NewTicker(time.Millisecond * relayInterval)
+	defer t.Stop()
+
+	var relayTxs types.Transactions
 	for {
 		select {
 		case _ = <-tp.stop:
 			return
 		case tx := <-tp.txChan:
-			go tp.broadcastTx(tx)
-		case tx := <-tp.recTx:
-			go tp.Add(tx, true)
+			relayTxs = append(relayTxs, tx)
+			if len(relayTxs) >= maxRelayTxs {
+				go tp.broadcastTxs(relayTxs, true)
+				relayTxs = nil
+			}
+		case <-t.C:
+			if len(relayTxs) != 0 {
+				go tp.broadcastTxs(relayTxs, true)
+				relayTxs = nil
+			}
+		case peerTx := <-tp.recTx:
+			go tp.addPeerTx(peerTx)
 		case txs := <-tp.removeTxsCh:
 			go tp.Remove(txs)
 		case _ = <-tp.stateUpdateCh:
-			go tp.txs.UpdateTxsList()
+			go tp.updateTxs()
 		}
 	}
 }
 
-// Broadcast transaction
-func (tp *TxPool) broadcastTx(tx types.ITransaction) {
+// Broadcast transactions, each peer receives them in one batch. If
+// skipKnown is true, the transactions that a peer already knows,
+// including those it sent, are not sent to it.
+func (tp *TxPool) broadcastTxs(txs types.Transactions, skipKnown bool) {
+	if len(txs) == 0 {
+		return
+	}
 	peers := tp.peerManager.Peers()
 	for id, _ := range peers {
 		if id != tp.peerManager.LocalPeerInfo().AddrInfo.ID.String() {
+			var peerTxs types.Transactions
+			for _, tx := range txs {
+				if tp.peerTxs.MarkSent(id, tx.Hash().String()) || !skipKnown {
+					peerTxs = append(peerTxs, tx)
+				}
+			}
+			if len(peerTxs) == 0 {
+				continue
+			}
 			peerId := new(peer.ID)
 			if err := peerId.UnmarshalText([]byte(id)); err == nil {
 				streamCreator := p2p.StreamCreator{PeerId: *peerId, NewStreamFunc: tp.newStream.CreateStream}
-				go tp.network.SendTransaction(&streamCreator, tx)
+				go tp.network.SendTransactions(&streamCreator, peerTxs)
 			}
 		}
 	}
@@ -125,6 +347,32 @@ func (tp *TxPool) Add(tx types.ITransaction, isPeer bool) error {
 	return tp.AddTransaction(tx, isPeer)
 }
 
+// Add a transaction relayed by a peer. The peer knows the transaction,
+// so it is not sent back. A peer that keeps sending transactions that
+// are invalid regardless of the state is disconnected, and its
+// transactions are ignored while it is banned.
+func (tp *TxPool) addPeerTx(peerTx *core.PeerTx) {
+	if tp.peerTxs.IsBanned(peerTx.PeerId) {
+		return
+	}
+	tp.peerTxs.MarkKnown(peerTx.PeerId, peerTx.Tx.Hash().String())
+	if err := tp.AddTransaction(peerTx.Tx, true); err != nil {
+		if _, ok := err.(*invalidTxError); ok && tp.peerTxs.Penalize(peerTx.PeerId, invalidTxPenalty) {
+			log.Warn("Ban peer for sending invalid transactions", "peer", peerTx.PeerId)
+			tp.peerManager.Remove(peerTx.PeerId)
+		}
+	}
+}
+
+// Forget the relay state of the peers that are disconnected
+func (tp *TxPool) cleanPeerTxs() {
+	connected := make(map[string]bool)
+	for id := range tp.peerManager.Peers() {
+		connected[id] = true
+	}
+	tp.peerTxs.Clean(connected)
+}
+
 // Verify adding transactions to the transaction pool
 func (tp *TxPool) AddTransaction(tx types.ITransaction, isPeer bool) error {
 	log.Info("TxPool receive transaction", "hash", tx.Hash())
@@ -132,17 +380,37 @@ func (tp *TxPool) AddTransaction(tx types.ITransaction, isPeer bool) error {
 		return errors.New("the transaction already exists")
 	}
 
-	if err := tp.verifyTx(tx); err != nil {
+	if err := tx.VerifyTx(); err != nil {
+		tp.txs.Reject(tx, err.Error())
+		return &invalidTxError{err}
+	}
+
+	if err := tp.verifyState(tx); err != nil {
+		tp.txs.Reject(tx, err.Error())
 		return err
 	}
 
-	if tp.txs.Len() >= maxPoolTx {
-		tp.txs.RemoveMinFeeTx(tx)
+	if err := tx.VerifyMinFees(tp.blockChain.GetMinFees()); err != nil {
+		tp.txs.Reject(tx, err.Error())
+		return err
 	}
 
-	if err := tp.txs.Put(tx); err != nil {
+	if err := tp.txs.MakeRoom(tx); err != nil {
+		tp.txs.Reject(tx, err.Error())
 		return err
 	}
+
+	if isPeer {
+		if err := tp.txs.Put(tx); err != nil {
+			tp.txs.Reject(tx, err.Error())
+			return err
+		}
+	} else {
+		if err := tp.txs.PutLocal(tx); err != nil {
+			tp.txs.Reject(tx, err.Error())
+			return err
+		}
+	}
 	log.Info("TxPool put transaction", "hash", tx.Hash())
 	//if !isPeer {
 	tp.txChan <- tx
@@ -150,18 +418,142 @@ func (tp *TxPool) AddTransaction(tx types.ITransaction, isPeer bool) error {
 	return nil
 }
 
-// Get transactions from the transaction pool
-func (tp *TxPool) Gets(count int) types.Transactions {
-	txs := tp.txs.Gets(count)
-	failed := types.Transactions{}
-	for i, tx := range txs {
-		if err := tp.verifyTx(tx); err != nil {
-			failed = append(failed, tx)
-			txs = append(txs[0:i], txs[i+1:txs.Len()]...)
+// Simulate the transaction without adding it to the pool. The same
+// checks as adding a transaction are run, then the transaction is
+// applied to copies of the accounts it changes, in the same way as
+// a block applies it.
+func (tp *TxPool) Simulate(tx types.ITransaction) *core.TxSimulation {
+	simulation := &core.TxSimulation{
+		Tx:          tx,
+		Fees:        types.Transactions{tx}.SumFees(),
+		Consumption: types.Transactions{tx}.SumConsumption(),
+	}
+	var addresses []hasharry.Address
+	accounts := make(map[string]types.IAccount)
+	getAccount := func(address hasharry.Address) types.IAccount {
+		account, ok := accounts[address.String()]
+		if !ok {
+			account = tp.accountState.GetAccountState(address)
+			accounts[address.String()] = account
+			addresses = append(addresses, address)
+		}
+		return account
+	}
+	defer func() {
+		for _, address := range addresses {
+			simulation.Accounts = append(simulation.Accounts, accounts[address.String()])
+		}
+	}()
+
+	getAccount(tx.From())
+	if err := tp.verifyTx(tx); err != nil {
+		simulation.Err = err
+		return simulation
+	}
+	if err := tx.VerifyMinFees(tp.blockChain.GetMinFees()); err != nil {
+		simulation.Err = err
+		return simulation
+	}
+	simulation.Err = tp.applyTx(tx, tp.blockChain.GetLastHeight()+1, getAccount)
+	return simulation
+}
+
+// Apply the transaction to the accounts
+func (tp *TxPool) applyTx(tx types.ITransaction, height uint64, getAccount func(hasharry.Address) types.IAccount) error {
+	if err := getAccount(tx.From()).FromChange(tx, height); err != nil {
+		return err
+	}
+	if tx.IsSponsored() {
+		if err := getAccount(tx.GetPayer()).PayFees(tx, height); err != nil {
+			return err
+		}
+	}
+	switch tx.GetTxType() {
+	case types.NormalTransaction, types.TimeLockTransaction, types.ContractTransaction:
+		return getAccount(tx.GetTxBody().ToAddress()).ToChange(tx, height)
+	case types.TransferFromTransaction:
+		body, ok := tx.GetTxBody().(*types.TransferFromBody)
+		if !ok {
+			return types.ErrTxBody
+		}
+		if err := getAccount(body.Owner).SpendAllowance(tx); err != nil {
+			return err
+		}
+		return getAccount(tx.GetTxBody().ToAddress()).ToChange(tx, height)
+	case types.HTLCClaimTransaction, types.HTLCRefundTransaction:
+		id, _ := types.HTLCId(tx)
+		htlc := tp.htlcState.GetHTLC(id)
+		if htlc == nil {
+			return fmt.Errorf("htlc %s is not exist", id.String())
+		}
+		receiver := htlc.Receiver(tx)
+		getAccount(receiver).ReceiveChange(receiver, htlc.Contract, htlc.Amount, height)
+	}
+	return nil
+}
+
+// Verify the issuance of a contract transaction against the coins
+// already issued in the block and record it. A new contract can only
+// be published once in a block.
+func (tp *TxPool) verifyIssue(tx types.ITransaction, issued map[string]uint64) error {
+	if tx.GetTxType() != types.ContractTransaction {
+		return nil
+	}
+	contractAddr := tx.GetTxBody().GetContract().String()
+	amount, ok := issued[contractAddr]
+	contract := tp.contractState.GetContract(contractAddr)
+	if contract == nil {
+		if ok {
+			return fmt.Errorf("contract %s has been published in the block", contractAddr)
+		}
+	} else if !ok {
+		amount = contract.Issued()
+	}
+	amount += tx.GetTxBody().GetAmount()
+	if amount > param.MaxAllContractCoin {
+		return fmt.Errorf("the total amount of money issued shall not exceed %d", param.MaxAllContractCoin)
+	}
+	issued[contractAddr] = amount
+	return nil
+}
+
+// Estimate the fee required for a transaction of the size to be packaged
+// into the next block. If the ready transactions leave room for it, the
+// minimum fee is enough, otherwise its fee rate needs to exceed that of
+// the first transaction it pushes out of the block.
+func (tp *TxPool) EstimateFees(size uint64) uint64 {
+	fees := tp.blockChain.GetMinFees()
+	if marginal := tp.marginalTx(size); marginal != nil {
+		if rateFees := marginal.GetFees()*size/marginal.Size() + 1; rateFees > fees {
+			fees = rateFees
 		}
 	}
-	tp.Remove(failed)
-	return txs
+	if fees > param.MaxFees {
+		return param.MaxFees
+	}
+	return fees
+}
+
+// The first ready transaction that no longer fits into the next block
+// when a transaction of the size is added, in the order of the block
+// template. Nil if all of them fit.
+func (tp *TxPool) marginalTx(size uint64) types.ITransaction {
+	minFees := tp.blockChain.GetMinFees()
+	count, used := 1, size
+	byFeeRate := tp.txs.ByFeeRate()
+	for tx := byFeeRate.Peek(); tx != nil; tx = byFeeRate.Peek() {
+		if tx.VerifyMinFees(minFees) != nil {
+			byFeeRate.Pop()
+			continue
+		}
+		if count >= param.MaxBlockTransactions || used+tx.Size() > param.MaxBlockSize {
+			return tx
+		}
+		count++
+		used += tx.Size()
+		byFeeRate.Shift()
+	}
+	return nil
 }
 
 // Get all transactions in the trading pool
@@ -170,6 +562,63 @@ func (tp *TxPool) GetAll() (types.Transactions, types.Transactions) {
 	return prepareTxs, futureTxs
 }
 
+// Get the status of all transactions submitted by the local node
+func (tp *TxPool) GetLocalTxs() []*core.LocalTxStatus {
+	var statuses []*core.LocalTxStatus
+	for _, local := range tp.txs.GetLocals() {
+		statuses = append(statuses, tp.localTxStatus(local))
+	}
+	return statuses
+}
+
+// Get the status of a transaction submitted by the local node
+func (tp *TxPool) GetLocalTx(hash hasharry.Hash) (*core.LocalTxStatus, error) {
+	local, ok := tp.txs.GetLocal(hash.String())
+	if !ok {
+		return nil, fmt.Errorf("local transaction %s is not exist", hash.String())
+	}
+	return tp.localTxStatus(local), nil
+}
+
+// Get the transactions of the address in the pool and the nonce
+// values missing before its future transactions
+func (tp *TxPool) GetAddressTxs(address hasharry.Address) *core.AddressTxs {
+	return tp.txs.GetAddressTxs(address)
+}
+
+// Get the counts, the total size and the oldest transaction of the pool
+func (tp *TxPool) GetStatus() *core.TxPoolStatus {
+	return tp.txs.GetStatus()
+}
+
+// Get the transactions recently rejected or evicted by the pool with
+// the reason, the latest first. Transactions that have been packaged
+// into a block leave the pool as well and are not reported.
+func (tp *TxPool) GetRejectedTxs() []*core.RejectedTx {
+	var rejected []*core.RejectedTx
+	for _, tx := range tp.txs.GetRejected() {
+		if _, err := tp.blockChain.GetTransactionIndex(tx.Tx.Hash()); err == nil {
+			continue
+		}
+		rejected = append(rejected, tx)
+	}
+	return rejected
+}
+
+func (tp *TxPool) localTxStatus(local *list.LocalTx) *core.LocalTxStatus {
+	status := &core.LocalTxStatus{Tx: local.Tx, Status: local.Status}
+	if index, err := tp.blockChain.GetTransactionIndex(local.Tx.Hash()); err == nil {
+		status.Status = core.LocalTxIncluded
+		status.Height = index.GetHeight()
+	} else if local.Status == core.LocalTxDropped {
+		status.Reason = local.Reason
+		if status.Reason == "" {
+			status.Reason = "removed from the pool"
+		}
+	}
+	return status
+}
+
 func (tp *TxPool) Get() types.ITransaction {
 	panic("implement me")
 }
@@ -179,12 +628,19 @@ func (tp *TxPool) Remove(txs types.Transactions) {
 	for _, tx := range txs {
 		switch tx.GetTxType {
 		default:
-			tp.txs.Remove(tx)
+			tp.txs.Remove(tx, "invalid transaction")
 		}
 	}
 
 }
 
+// Remove the rejected transactions and record the reasons
+func (tp *TxPool) RemoveRejected(rejected []*core.RejectedTx) {
+	for _, r := range rejected {
+		tp.txs.Remove(r.Tx, r.Reason)
+	}
+}
+
 func (tp *TxPool) IsExist(tx types.ITransaction) bool {
 	return tp.txs.IsExist(tx.From().String(), tx.Hash().String())
 }
@@ -194,6 +650,14 @@ func (tp *TxPool) verifyTx(tx types.ITransaction) error {
 	if err := tx.VerifyTx(); err != nil {
 		return err
 	}
+	return tp.verifyState(tx)
+}
+
+// Verify the transaction against the current state
+func (tp *TxPool) verifyState(tx types.ITransaction) error {
+	if tx.IsExpired(tp.blockChain.GetLastHeight() + 1) {
+		return types.ErrTxExpired
+	}
 
 	if err := tp.consensus.VerifyTx(tx); err != nil {
 		return err
@@ -207,10 +671,25 @@ func (tp *TxPool) verifyTx(tx types.ITransaction) error {
 		return err
 	}
 
+	if err := tp.htlcState.VerifyState(tx, tp.blockChain.GetLastHeight()+1); err != nil {
+		return err
+	}
+
+	if err := tp.nameState.VerifyState(tx, tp.blockChain.GetLastHeight()+1); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// Update the pool for the state of a new block, the transactions that
+// can no longer be included in the next block are removed first
+func (tp *TxPool) updateTxs() {
+	tp.clearExpiredTx()
+	tp.txs.UpdateTxsList()
+}
+
 func (tp *TxPool) clearExpiredTx() {
-	timeThreshold := time.Now().Unix() - list.TxLifeTime
-	tp.txs.RemoveExpiredTx(uint64(timeThreshold))
+	timeThreshold := time.Now().Unix() - tp.policy.LifeTime
+	tp.txs.RemoveExpiredTx(uint64(timeThreshold), tp.blockChain.GetLastHeight()+1)
 }
@@ -0,0 +1,197 @@
+package txmgr
+
+import (
+	"github.com/uworldao/UWORLD/common/hasharry"
+	"github.com/uworldao/UWORLD/core"
+	"github.com/uworldao/UWORLD/core/types"
+	"github.com/uworldao/UWORLD/param"
+	"github.com/uworldao/UWORLD/services/txmgr/list"
+	"testing"
+)
+
+func TestTxPool_ApplyTx(t *testing.T) {
+	from := hasharry.StringToAddress("UWDM1qcsk7UUNANMPKSpALJW7AqpDCy7tdoN")
+	to := hasharry.StringToAddress("UWDNQhgkNHCLdVhCFvpo6bGXXdcKtTTfeQZE")
+	fromAccount := types.NewAccount()
+	fromAccount.Address = from
+	tokenAccount, _ := fromAccount.Coins.Get(param.Token.String())
+	tokenAccount.Balance = 10 * param.AtomsPerCoin
+	accounts := map[string]types.IAccount{
+		from.String(): fromAccount,
+		to.String():   types.NewAccount(),
+	}
+	getAccount := func(address hasharry.Address) types.IAccount {
+		return accounts[address.String()]
+	}
+
+	tx := &types.Transaction{
+		TxHead: &types.TransactionHead{
+			TxType: types.NormalTransaction,
+			From:   from,
+			Nonce:  1,
+			Fees:   param.Fees,
+			Time:   1,
+		},
+		TxBody: &types.NormalTransactionBody{
+			Contract: param.Token,
+			To:       to,
+			Amount:   param.AtomsPerCoin,
+		},
+	}
+	tp := &TxPool{}
+	if err := tp.applyTx(tx, 1, getAccount); err != nil {
+		t.Fatal(err)
+	}
+	if balance := accounts[from.String()].GetBalance(param.Token.String()); balance != 9*param.AtomsPerCoin {
+		t.Fatalf("wrong sender balance %d", balance)
+	}
+	// The fees of a token transfer are deducted from the amount, the
+	// amount is locked until the block is confirmed
+	if lockedOut := accounts[to.String()].(*types.Account).GetLockedOut(param.Token.String()); lockedOut != param.AtomsPerCoin-param.Fees {
+		t.Fatalf("wrong receiver amount %d", lockedOut)
+	}
+
+	tx.TxHead.Nonce = 3
+	if err := tp.applyTx(tx, 1, getAccount); err != types.ErrNonce {
+		t.Fatalf("expected ErrNonce, got %v", err)
+	}
+}
+
+func TestScratchState_Revert(t *testing.T) {
+	from := hasharry.StringToAddress("UWDM1qcsk7UUNANMPKSpALJW7AqpDCy7tdoN")
+	getState := func(address hasharry.Address) types.IAccount {
+		account := types.NewAccount()
+		account.Address = address
+		tokenAccount, _ := account.Coins.Get(param.Token.String())
+		tokenAccount.Balance = 10 * param.AtomsPerCoin
+		return account
+	}
+	tx := &types.Transaction{
+		TxHead: &types.TransactionHead{
+			TxType: types.NormalTransaction,
+			From:   from,
+			Nonce:  1,
+			Fees:   param.Fees,
+			Time:   1,
+		},
+		TxBody: &types.NormalTransactionBody{
+			Contract: param.Token,
+			To:       hasharry.StringToAddress("UWDNQhgkNHCLdVhCFvpo6bGXXdcKtTTfeQZE"),
+			Amount:   param.AtomsPerCoin,
+		},
+	}
+	state := newScratchState(getState)
+	if err := state.GetAccount(from).FromChange(tx, 1); err != nil {
+		t.Fatal(err)
+	}
+	state.Commit()
+
+	tx.TxHead.Nonce = 2
+	if err := state.GetAccount(from).FromChange(tx, 1); err != nil {
+		t.Fatal(err)
+	}
+	state.Revert()
+	account := state.GetAccount(from)
+	if account.GetNonce() != 1 || account.GetBalance(param.Token.String()) != 9*param.AtomsPerCoin {
+		t.Fatalf("the second transaction should be reverted, nonce %d", account.GetNonce())
+	}
+}
+
+// The chain of the next block with a fixed minimum fee
+type feesChain struct {
+	core.IBlockChain
+	minFees uint64
+}
+
+func (c *feesChain) GetMinFees() uint64 {
+	return c.minFees
+}
+
+func (c *feesChain) GetLastHeight() uint64 {
+	return 1
+}
+
+// The nonces of the accounts without any transaction
+type emptyState struct {
+	core.IAccountState
+}
+
+func (s *emptyState) GetAccountNonce(hasharry.Address) (uint64, error) {
+	return 0, nil
+}
+
+func TestTxPool_EstimateFees(t *testing.T) {
+	from := hasharry.StringToAddress("UWDM1qcsk7UUNANMPKSpALJW7AqpDCy7tdoN")
+	tp := &TxPool{
+		blockChain: &feesChain{minFees: param.Fees},
+		txs:        list.NewTxList(&emptyState{}, nil, &list.Policy{Capacity: 2000, MaxBytes: param.MaxBlockSize * 2, AccountTxs: 2000}),
+	}
+	newTx := func(nonce, fees uint64) types.ITransaction {
+		tx := &types.Transaction{
+			TxHead: &types.TransactionHead{TxType: types.NormalTransaction, From: from, Nonce: nonce, Fees: fees, Time: nonce},
+			TxBody: &types.NormalTransactionBody{Contract: param.Token, To: from, Amount: param.AtomsPerCoin},
+		}
+		tx.SetHash()
+		return tx
+	}
+
+	// The minimum fee is enough when the block has room
+	if fees := tp.EstimateFees(300); fees != param.Fees {
+		t.Fatalf("wrong fees %d", fees)
+	}
+
+	// The block is full by the count, the fee rate needs to exceed that
+	// of the first transaction left out, so a larger transaction pays more
+	var marginal types.ITransaction
+	for nonce := uint64(1); nonce <= param.MaxBlockTransactions; nonce++ {
+		tx := newTx(nonce, param.Fees*2)
+		if err := tp.txs.Put(tx); err != nil {
+			t.Fatal(err)
+		}
+		if nonce == param.MaxBlockTransactions {
+			marginal = tx
+		}
+	}
+	size := marginal.Size()
+	if fees := tp.EstimateFees(size); fees != param.Fees*2+1 {
+		t.Fatalf("wrong fees %d", fees)
+	}
+	if fees := tp.EstimateFees(size * 2); fees != param.Fees*4+1 {
+		t.Fatalf("wrong fees %d of the larger transaction", fees)
+	}
+	if fees := tp.EstimateFees(param.MaxBlockSize); fees != param.MaxFees {
+		t.Fatalf("the fees should not exceed %d, got %d", param.MaxFees, fees)
+	}
+}
+
+// Building the template only reports the invalid transactions, the
+// miner removes them from the pool
+func TestTxPool_GetBlockTemplate(t *testing.T) {
+	from := hasharry.StringToAddress("UWDM1qcsk7UUNANMPKSpALJW7AqpDCy7tdoN")
+	tp := &TxPool{
+		blockChain:   &feesChain{minFees: param.Fees},
+		accountState: &emptyState{},
+		txs:          list.NewTxList(&emptyState{}, nil, &list.Policy{Capacity: 10, MaxBytes: param.MaxBlockSize, AccountTxs: 10}),
+	}
+	// The transaction is not signed
+	tx := &types.Transaction{
+		TxHead: &types.TransactionHead{TxType: types.NormalTransaction, From: from, Nonce: 1, Fees: param.Fees, Time: 1},
+		TxBody: &types.NormalTransactionBody{Contract: param.Token, To: from, Amount: param.AtomsPerCoin},
+	}
+	tx.SetHash()
+	if err := tp.txs.Put(tx); err != nil {
+		t.Fatal(err)
+	}
+
+	template := tp.GetBlockTemplate(param.MaxBlockTransactions, param.MaxBlockSize)
+	if len(template.Txs) != 0 || len(template.Failed) != 1 || len(template.Invalid) != 1 {
+		t.Fatalf("the invalid transaction should fail, %d failed", len(template.Failed))
+	}
+	if !tp.IsExist(tx) {
+		t.Fatal("building the template should not change the pool")
+	}
+	tp.RemoveRejected(template.Invalid)
+	if tp.IsExist(tx) {
+		t.Fatal("the invalid transaction should be removed")
+	}
+}
@@ -29,6 +29,231 @@ func NewTransaction(from, to, token string, note string, amount, nonce uint64) *
 	return tx
 }
 
+// The amount received is locked until the unlock height and unlock time
+func NewTimeLockTransaction(from, to, token string, note string, amount, nonce, unlockHeight, unlockTime uint64) *types.Transaction {
+	tx := &types.Transaction{
+		TxHead: &types.TransactionHead{
+			TxType:     types.TimeLockTransaction,
+			TxHash:     hasharry.Hash{},
+			From:       hasharry.StringToAddress(from),
+			Nonce:      nonce,
+			Time:       uint64(time.Now().Unix()),
+			Note:       note,
+			SignScript: &types.SignScript{},
+			Fees:       param.Fees,
+		},
+		TxBody: &types.TimeLockTransactionBody{
+			Contract:     hasharry.StringToAddress(token),
+			To:           hasharry.StringToAddress(to),
+			Amount:       amount,
+			UnlockHeight: unlockHeight,
+			UnlockTime:   unlockTime,
+		},
+	}
+	tx.SetHash()
+	return tx
+}
+
+func newHead(txType types.TransactionType, from string, note string, nonce uint64) *types.TransactionHead {
+	return &types.TransactionHead{
+		TxType:     txType,
+		TxHash:     hasharry.Hash{},
+		From:       hasharry.StringToAddress(from),
+		Nonce:      nonce,
+		Time:       uint64(time.Now().Unix()),
+		Note:       note,
+		SignScript: &types.SignScript{},
+		Fees:       param.Fees,
+	}
+}
+
+// Destroy the amount of UWD or contract coins held by the sender
+func NewBurn(from, token string, note string, amount, nonce uint64) *types.Transaction {
+	tx := &types.Transaction{
+		TxHead: newHead(types.BurnTransaction, from, note, nonce),
+		TxBody: &types.BurnBody{
+			Contract: hasharry.StringToAddress(token),
+			Amount:   amount,
+		},
+	}
+	tx.SetHash()
+	return tx
+}
+
+// Grant the spender an allowance of the token, 0 revokes the allowance
+func NewApprove(from, spender, token string, note string, allowance, nonce uint64) *types.Transaction {
+	tx := &types.Transaction{
+		TxHead: newHead(types.ApproveTransaction, from, note, nonce),
+		TxBody: &types.ApproveBody{
+			Contract:  hasharry.StringToAddress(token),
+			Spender:   hasharry.StringToAddress(spender),
+			Allowance: allowance,
+		},
+	}
+	tx.SetHash()
+	return tx
+}
+
+// Transfer the amount of the owner to the receiver within the
+// allowance granted to the sender
+func NewTransferFrom(from, owner, to, token string, note string, amount, nonce uint64) *types.Transaction {
+	tx := &types.Transaction{
+		TxHead: newHead(types.TransferFromTransaction, from, note, nonce),
+		TxBody: &types.TransferFromBody{
+			Contract: hasharry.StringToAddress(token),
+			Owner:    hasharry.StringToAddress(owner),
+			To:       hasharry.StringToAddress(to),
+			Amount:   amount,
+		},
+	}
+	tx.SetHash()
+	return tx
+}
+
+// Change the policy of the contract, the holder is empty
+// for pausing and unpausing the contract
+func NewContractPolicy(from, contract, note string, nonce uint64, action types.PolicyAction, holder string) *types.Transaction {
+	tx := &types.Transaction{
+		TxHead: newHead(types.ContractPolicyTransaction, from, note, nonce),
+		TxBody: &types.ContractPolicyBody{
+			Contract: hasharry.StringToAddress(contract),
+			Action:   action,
+			Holder:   hasharry.StringToAddress(holder),
+		},
+	}
+	tx.SetHash()
+	return tx
+}
+
+// Update the description and the metadata uri of the contract
+func NewContractMetadata(from, contract, note string, nonce uint64, description, metadataURI string) *types.Transaction {
+	tx := &types.Transaction{
+		TxHead: newHead(types.ContractMetadataTransaction, from, note, nonce),
+		TxBody: &types.ContractMetadataBody{
+			Contract:    hasharry.StringToAddress(contract),
+			Description: description,
+			MetadataURI: metadataURI,
+		},
+	}
+	tx.SetHash()
+	return tx
+}
+
+// Transfer the issuer rights of the contract to the issuer
+func NewContractIssuer(from, contract, note string, nonce uint64, issuer string) *types.Transaction {
+	tx := &types.Transaction{
+		TxHead: newHead(types.ContractIssuerTransaction, from, note, nonce),
+		TxBody: &types.ContractIssuerBody{
+			Contract: hasharry.StringToAddress(contract),
+			Issuer:   hasharry.StringToAddress(issuer),
+		},
+	}
+	tx.SetHash()
+	return tx
+}
+
+// Register the name for the sender for a period, the fees
+// of the transaction are the name consumption
+func NewNameRegister(from, name, note string, nonce uint64) *types.Transaction {
+	head := newHead(types.NameRegisterTransaction, from, note, nonce)
+	head.Fees = param.NameConsumption
+	tx := &types.Transaction{
+		TxHead: head,
+		TxBody: &types.NameRegisterBody{Name: name},
+	}
+	tx.SetHash()
+	return tx
+}
+
+// Extend the name owned by the sender for a period
+func NewNameRenew(from, name, note string, nonce uint64) *types.Transaction {
+	head := newHead(types.NameRenewTransaction, from, note, nonce)
+	head.Fees = param.NameConsumption
+	tx := &types.Transaction{
+		TxHead: head,
+		TxBody: &types.NameRenewBody{Name: name},
+	}
+	tx.SetHash()
+	return tx
+}
+
+// Transfer the name owned by the sender to the receiver
+func NewNameTransfer(from, name, to, note string, nonce uint64) *types.Transaction {
+	tx := &types.Transaction{
+		TxHead: newHead(types.NameTransferTransaction, from, note, nonce),
+		TxBody: &types.NameTransferBody{
+			Name: name,
+			To:   hasharry.StringToAddress(to),
+		},
+	}
+	tx.SetHash()
+	return tx
+}
+
+// Anchor the digests of documents on the chain without a transfer
+func NewAnchor(from string, note string, nonce uint64, digests []hasharry.Hash) *types.Transaction {
+	tx := &types.Transaction{
+		TxHead: newHead(types.AnchorTransaction, from, note, nonce),
+		TxBody: &types.AnchorBody{
+			Digests: digests,
+		},
+	}
+	tx.SetHash()
+	return tx
+}
+
+// Bind the new public key to the sender, the address and its
+// state are kept and only the controlling key changes
+func NewKeyRotation(from string, note string, nonce uint64, pubKey []byte) *types.Transaction {
+	tx := &types.Transaction{
+		TxHead: newHead(types.KeyRotationTransaction, from, note, nonce),
+		TxBody: &types.KeyRotationBody{
+			PubKey: pubKey,
+		},
+	}
+	tx.SetHash()
+	return tx
+}
+
+// Escrow the amount under the hash lock until the expire height
+func NewHTLCLock(from, to, token string, note string, amount, nonce uint64, hashLock hasharry.Hash, expireHeight uint64) *types.Transaction {
+	tx := &types.Transaction{
+		TxHead: newHead(types.HTLCLockTransaction, from, note, nonce),
+		TxBody: &types.HTLCLockBody{
+			Contract:     hasharry.StringToAddress(token),
+			To:           hasharry.StringToAddress(to),
+			Amount:       amount,
+			HashLock:     hashLock,
+			ExpireHeight: expireHeight,
+		},
+	}
+	tx.SetHash()
+	return tx
+}
+
+// Claim the escrowed amount of the HTLC with the preimage
+func NewHTLCClaim(from string, note string, nonce uint64, id hasharry.Hash, preimage []byte) *types.Transaction {
+	tx := &types.Transaction{
+		TxHead: newHead(types.HTLCClaimTransaction, from, note, nonce),
+		TxBody: &types.HTLCClaimBody{
+			Id:       id,
+			Preimage: preimage,
+		},
+	}
+	tx.SetHash()
+	return tx
+}
+
+// Refund the escrowed amount of the expired HTLC
+func NewHTLCRefund(from string, note string, nonce uint64, id hasharry.Hash) *types.Transaction {
+	tx := &types.Transaction{
+		TxHead: newHead(types.HTLCRefundTransaction, from, note, nonce),
+		TxBody: &types.HTLCRefundBody{Id: id},
+	}
+	tx.SetHash()
+	return tx
+}
+
 func NewContract(from, to, contract string, note string, amount, nonce uint64, name, abbr string, increase bool, description string) *types.Transaction {
 	tx := &types.Transaction{
 		TxHead: &types.TransactionHead{